@@ -39,6 +39,16 @@ func (v *Value) SetValue(val float64) {
 	}
 }
 
+// reset cancels any running animations and sets v to 0, calling any subscribed functions even if it was already 0.
+// It is used by values that interpolate between endpoints that have changed.
+func (v *Value) reset() {
+	if v.animation != nil {
+		v.animation.cancel()
+	}
+	v.value = 0
+	v.relay.Signal()
+}
+
 func (v *Value) setValue(val float64) {
 	if v.value != val {
 		v.value = val
@@ -46,33 +56,64 @@ func (v *Value) setValue(val float64) {
 	}
 }
 
-// Run runs animation a on v. Cancels any previously running animations on v.
-func (v *Value) Run(a Animation) (cancelFunc func()) {
+// Run runs animation a on v. Cancels any previously running animations on v. It returns a Handle that can cancel a,
+// and reports when it ends.
+func (v *Value) Run(a Animation) *Handle {
 	if v.animation != nil {
 		v.animation.cancel()
 	}
 
-	start := time.Now()
-	an := &animation{animation: a, ticker: internal.NewTicker(time.Hour * 99), value: v}
+	start := internal.Now()
+	an := &animation{animation: a, start: start, ticker: internal.NewTicker(time.Hour * 99), value: v}
+	an.handle = newHandle(an.cancel)
 	an.tickerId = an.ticker.Notify(func() {
 		matcha.MainLocker.Lock()
 		defer matcha.MainLocker.Unlock()
-		if an.cancelled {
+		if an.ended {
 			return
 		}
 
-		d := time.Now().Sub(start)
+		d := internal.Now().Sub(start)
 
 		v.setValue(a.Tick(d))
-		if d > a.Duration() {
-			an.cancel()
+		if d >= a.Duration() {
+			an.end(true)
 		}
 	})
 	v.animation = an
+	return an.handle
+}
+
+// Velocity returns the velocity of the animation running on v in units per second, or 0 if it is not running a
+// VelocityAnimation.
+func (v *Value) Velocity() float64 {
+	if v.animation == nil {
+		return 0
+	}
+	a, ok := v.animation.animation.(VelocityAnimation)
+	if !ok {
+		return 0
+	}
+	return a.VelocityAt(internal.Now().Sub(v.animation.start))
+}
 
-	return func() {
-		an.cancel()
+// Retarget changes the end of the Spring running on v, preserving its current value and velocity. If v is not running
+// a Spring, a new Spring with default parameters is started. The Handle of the replaced animation reports that it was
+// cancelled.
+//
+//	// The card was dismissed while springing open, so spring it closed from wherever it is.
+//	offset.Retarget(0)
+func (v *Value) Retarget(end float64) *Handle {
+	s := &Spring{}
+	if v.animation != nil {
+		if running, ok := v.animation.animation.(*Spring); ok {
+			*s = *running
+		}
 	}
+	s.Start = v.Value()
+	s.Velocity = v.Velocity()
+	s.End = end
+	return v.Run(s)
 }
 
 // Animation is an interface that represents a float64 that changes over a fixed duration.
@@ -81,26 +122,39 @@ type Animation interface {
 	Tick(time.Duration) float64
 }
 
+// VelocityAnimation is an Animation that can report its velocity in units per second, so that animations that
+// replace it can continue smoothly.
+type VelocityAnimation interface {
+	Animation
+	VelocityAt(time.Duration) float64
+}
+
 type animation struct {
-	cancelled  bool
-	animation  Animation
-	ticker     *internal.Ticker
-	tickerId   comm.Id
-	onComplete func()
-	value      *Value
+	ended     bool
+	animation Animation
+	start     time.Time
+	ticker    *internal.Ticker
+	tickerId  comm.Id
+	handle    *Handle
+	value     *Value
 }
 
 func (a *animation) cancel() {
-	if a.cancelled {
+	a.end(false)
+}
+
+func (a *animation) end(finished bool) {
+	if a.ended {
 		return
 	}
+	a.ended = true
 
 	a.ticker.Unnotify(a.tickerId)
-	a.value.animation = nil
-	if a.onComplete != nil {
-		a.onComplete()
+	a.ticker.Stop()
+	if a.value.animation == a {
+		a.value.animation = nil
 	}
-	a.cancelled = true
+	a.handle.end(finished)
 }
 
 // Basic is an animation that goes from Start to End with duration Dur.
@@ -132,49 +186,3 @@ func (a *Basic) Tick(t time.Duration) float64 {
 	}
 	return a.Start + ratio*(a.End-a.Start)
 }
-
-// type Spring struct {
-// 	Start     float64
-// 	End       float64
-// 	Velocity  float64
-// 	Stiffness float64
-// 	Dampening float64
-// }
-
-// func (a *Spring) Duration() time.Duration {
-// 	return time.Duration(1)
-// }
-
-// func (a *Spring) SetTime(t time.Duration) {
-// }
-
-// func (a *Spring) Value() float64 {
-// 	return 0
-// }
-
-// type Decay struct {
-// 	Start        float64
-// 	End          float64
-// 	Velocity     float64 // units/second
-// 	Deceleration float64
-// }
-
-// func (a *Decay) Duration() time.Duration {
-// 	return time.Duration(1)
-// }
-
-// func (a *Decay) SetTime(t time.Duration) {
-// }
-
-// func (a *Decay) Value() float64 {
-// 	return 0
-// }
-
-// func Reverse(a animation) animation {
-// }
-
-// func Delay(a animation) animation {
-// }
-
-// func Repeat(a animation) animation {
-// }
@@ -0,0 +1,72 @@
+package animate
+
+import (
+	"sync"
+	"time"
+
+	"gomatcha.io/matcha/internal"
+)
+
+// Clock is a source of time for animations.
+type Clock interface {
+	Now() time.Time
+}
+
+// SetClock replaces the clock used by animations and view transitions. If c is nil, the system clock is restored.
+// Frames are still delivered by the display link, or by Step.
+func SetClock(c Clock) {
+	internal.SetClock(c)
+}
+
+// Step delivers a frame to all running animations, as the display link does on each screen refresh. It must not be
+// called while matcha.MainLocker is held.
+func Step() {
+	internal.ScreenUpdate()
+}
+
+// ManualClock is a Clock that only changes when it is told to, so that animations can be tested deterministically.
+//
+//	clock := animate.NewManualClock()
+//	animate.SetClock(clock)
+//	defer animate.SetClock(nil)
+//
+//	value.Run(&animate.Basic{End: 1, Dur: time.Second})
+//	clock.Step(time.Second / 2)
+//	// value.Value() == 0.5
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock returns a ManualClock set to the current time.
+func NewManualClock() *ManualClock {
+	return &ManualClock{now: time.Now()}
+}
+
+// Now implements the Clock interface.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set sets the time of c, without delivering a frame.
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves c forward by d, without delivering a frame.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Step moves c forward by d and delivers a frame to all running animations. It must not be called while
+// matcha.MainLocker is held.
+func (c *ManualClock) Step(d time.Duration) {
+	c.Advance(d)
+	Step()
+}
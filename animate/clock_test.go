@@ -0,0 +1,46 @@
+package animate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestManualClock(t *testing.T) {
+	clock := NewManualClock()
+	SetClock(clock)
+	defer SetClock(nil)
+
+	v := &Value{}
+	h := v.Run(&Basic{End: 10, Dur: time.Second})
+	values := []float64{}
+	for i := 0; i < 4; i++ {
+		clock.Step(time.Second / 4)
+		values = append(values, v.Value())
+	}
+	expected := []float64{2.5, 5, 7.5, 10}
+	for i := range expected {
+		if math.Abs(values[i]-expected[i]) > 1e-9 {
+			t.Fatalf("Incorrect values: %v, expected %v", values, expected)
+		}
+	}
+	if !h.Finished() || h.Running() {
+		t.Error("Expected the animation to finish on the frame at its end")
+	}
+
+	// Advancing without a frame doesn't update the value.
+	s := &Spring{End: 1, Velocity: 2}
+	v.Run(s)
+	clock.Advance(100 * time.Millisecond)
+	if v.Value() != 10 {
+		t.Errorf("Value changed without a frame: %v", v.Value())
+	}
+	if vel := v.Velocity(); vel != s.VelocityAt(100*time.Millisecond) {
+		t.Errorf("Incorrect velocity: %v", vel)
+	}
+	Step()
+	if v.Value() != s.Tick(100*time.Millisecond) {
+		t.Errorf("Incorrect value: %v", v.Value())
+	}
+	v.SetValue(0)
+}
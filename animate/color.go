@@ -2,6 +2,7 @@ package animate
 
 import (
 	"image/color"
+	"math"
 
 	"gomatcha.io/matcha/comm"
 )
@@ -60,5 +61,217 @@ func (e RGBALerp) Notifier(n comm.Float64Notifier) comm.ColorNotifier {
 }
 
 func uintInterpolate(a, b uint32, c float64) uint16 {
-	return uint16(a + uint32(float64(b-a)*c))
+	return clampChannel(float64(a) + (float64(b)-float64(a))*c)
+}
+
+// clampChannel rounds v to the nearest 16-bit channel value.
+func clampChannel(v float64) uint16 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 0xffff {
+		return 0xffff
+	}
+	return uint16(v + 0.5)
+}
+
+// ColorSpace is the color space that colors are interpolated in.
+type ColorSpace int
+
+const (
+	// ColorSpaceRGB interpolates the gamma encoded sRGB channels, like RGBALerp.
+	ColorSpaceRGB ColorSpace = iota
+	// ColorSpaceLinearRGB interpolates the sRGB channels after removing gamma, which keeps mixed colors bright.
+	ColorSpaceLinearRGB
+	// ColorSpaceHSL interpolates hue along the shorter way around the color wheel, along with saturation and lightness.
+	ColorSpaceHSL
+	// ColorSpaceOKLab interpolates in the perceptually uniform OKLab space, which keeps the perceived lightness even.
+	ColorSpaceOKLab
+)
+
+// ColorLerp interpolates between colors Start and End in Space. A nil color is transparent.
+type ColorLerp struct {
+	Start, End color.Color
+	Space      ColorSpace
+}
+
+// Interpolate implements the ColorInterpolater interface
+func (e ColorLerp) Interpolate(a float64) color.Color {
+	return LerpColor(e.Start, e.End, a, e.Space)
+}
+
+// Notifier is a convenience method around animate.ColorInterpolate(n, e)
+func (e ColorLerp) Notifier(n comm.Float64Notifier) comm.ColorNotifier {
+	return ColorInterpolate(n, e)
+}
+
+// LerpColor returns the color between a and b at ratio t in space, where 0 is a and 1 is b. A nil color is transparent.
+// Ratios outside of 0-1 extrapolate, and the result is clamped to valid colors.
+func LerpColor(a, b color.Color, t float64, space ColorSpace) color.Color {
+	ca, cb := toColorf(a), toColorf(b)
+	switch space {
+	case ColorSpaceLinearRGB:
+		return lerpColorf(ca.linear(), cb.linear(), t).fromLinear().rgba64()
+	case ColorSpaceHSL:
+		return lerpHSL(ca, cb, t)
+	case ColorSpaceOKLab:
+		return lerpColorf(ca.oklab(), cb.oklab(), t).fromOKLab().rgba64()
+	default:
+		return lerpColorf(ca, cb, t).rgba64()
+	}
+}
+
+// colorf is a color with straight alpha and float channels. Depending on the color space, r, g and b may be gamma
+// encoded sRGB, linear sRGB, or OKLab's L, a and b.
+type colorf struct {
+	r, g, b, a float64
+}
+
+func toColorf(c color.Color) colorf {
+	if c == nil {
+		return colorf{}
+	}
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return colorf{}
+	}
+	return colorf{float64(r) / float64(a), float64(g) / float64(a), float64(b) / float64(a), float64(a) / 0xffff}
+}
+
+func (c colorf) rgba64() color.RGBA64 {
+	a := math.Max(0, math.Min(1, c.a))
+	ch := func(v float64) uint16 {
+		return clampChannel(math.Max(0, math.Min(1, v)) * a * 0xffff)
+	}
+	return color.RGBA64{R: ch(c.r), G: ch(c.g), B: ch(c.b), A: clampChannel(a * 0xffff)}
+}
+
+// lerpColorf interpolates the premultiplied channels of a and b, so that transparent colors don't tint the result.
+func lerpColorf(a, b colorf, t float64) colorf {
+	alpha := a.a + (b.a-a.a)*t
+	if alpha <= 0 {
+		return colorf{}
+	}
+	ch := func(x, y float64) float64 {
+		return (x*a.a + (y*b.a-x*a.a)*t) / alpha
+	}
+	return colorf{ch(a.r, b.r), ch(a.g, b.g), ch(a.b, b.b), alpha}
+}
+
+func toLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func fromLinear(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+func (c colorf) linear() colorf {
+	return colorf{toLinear(c.r), toLinear(c.g), toLinear(c.b), c.a}
+}
+
+func (c colorf) fromLinear() colorf {
+	return colorf{fromLinear(c.r), fromLinear(c.g), fromLinear(c.b), c.a}
+}
+
+// oklab converts c to OKLab, as described at https://bottosson.github.io/posts/oklab/.
+func (c colorf) oklab() colorf {
+	c = c.linear()
+	l := math.Cbrt(0.4122214708*c.r + 0.5363325363*c.g + 0.0514459929*c.b)
+	m := math.Cbrt(0.2119034982*c.r + 0.6806995451*c.g + 0.1073969566*c.b)
+	s := math.Cbrt(0.0883024619*c.r + 0.2817188376*c.g + 0.6299787005*c.b)
+	return colorf{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+		c.a,
+	}
+}
+
+func (c colorf) fromOKLab() colorf {
+	l := c.r + 0.3963377774*c.g + 0.2158037573*c.b
+	m := c.r - 0.1055613458*c.g - 0.0638541728*c.b
+	s := c.r - 0.0894841775*c.g - 1.2914855480*c.b
+	l, m, s = l*l*l, m*m*m, s*s*s
+	return colorf{
+		4.0767416621*l - 3.3077115913*m + 0.2309699292*s,
+		-1.2684380046*l + 2.6097574011*m - 0.3413193965*s,
+		-0.0041960863*l - 0.7034186147*m + 1.7076147010*s,
+		c.a,
+	}.fromLinear()
+}
+
+// hsl returns the hue in degrees, and the saturation and lightness between 0-1.
+func (c colorf) hsl() (h, s, l float64) {
+	max := math.Max(c.r, math.Max(c.g, c.b))
+	min := math.Min(c.r, math.Min(c.g, c.b))
+	l = (max + min) / 2
+	d := max - min
+	if d == 0 {
+		return 0, 0, l
+	}
+	s = d / (1 - math.Abs(2*l-1))
+	switch max {
+	case c.r:
+		h = math.Mod((c.g-c.b)/d+6, 6)
+	case c.g:
+		h = (c.b-c.r)/d + 2
+	default:
+		h = (c.r-c.g)/d + 4
+	}
+	return h * 60, s, l
+}
+
+func fromHSL(h, s, l, a float64) colorf {
+	h = math.Mod(math.Mod(h, 360)+360, 360)
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return colorf{r + m, g + m, b + m, a}
+}
+
+func lerpHSL(a, b colorf, t float64) color.Color {
+	h1, s1, l1 := a.hsl()
+	h2, s2, l2 := b.hsl()
+	// Colors without a hue or that are transparent take on the other's, so that they don't rotate through the wheel.
+	if s1 == 0 || a.a == 0 {
+		h1 = h2
+	}
+	if s2 == 0 || b.a == 0 {
+		h2 = h1
+	}
+	if a.a == 0 {
+		s1, l1 = s2, l2
+	}
+	if b.a == 0 {
+		s2, l2 = s1, l1
+	}
+	dh := math.Mod(h2-h1+540, 360) - 180
+	lerp := func(x, y float64) float64 {
+		return x + (y-x)*t
+	}
+	s := math.Max(0, math.Min(1, lerp(s1, s2)))
+	l := math.Max(0, math.Min(1, lerp(l1, l2)))
+	return fromHSL(h1+dh*t, s, l, lerp(a.a, b.a)).rgba64()
 }
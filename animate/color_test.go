@@ -0,0 +1,79 @@
+package animate
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestRGBALerp(t *testing.T) {
+	// Interpolating from a larger channel to a smaller one shouldn't underflow.
+	l := RGBALerp{Start: color.RGBA{0xff, 0, 0, 0xff}, End: color.RGBA{0, 0xff, 0, 0xff}}
+	if c := l.Interpolate(0.5); c != (color.RGBA64{0x8000, 0x8000, 0, 0xffff}) {
+		t.Errorf("Incorrect color: %v", c)
+	}
+	if c := l.Interpolate(1.5); c != (color.RGBA64{0, 0xffff, 0, 0xffff}) {
+		t.Errorf("Expected overshoot to be clamped: %v", c)
+	}
+}
+
+func rgba(c color.Color) color.RGBA {
+	return color.RGBAModel.Convert(c).(color.RGBA)
+}
+
+// similar returns true if each channel of a and b differs by at most 1.
+func similar(a, b color.RGBA) bool {
+	d := func(x, y uint8) bool {
+		return x-y <= 1 || y-x <= 1
+	}
+	return d(a.R, b.R) && d(a.G, b.G) && d(a.B, b.B) && d(a.A, b.A)
+}
+
+func TestLerpColor(t *testing.T) {
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	green := color.RGBA{0, 0xff, 0, 0xff}
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+	black := color.RGBA{0, 0, 0, 0xff}
+	magenta := color.RGBA{0xff, 0, 0xff, 0xff}
+
+	for _, space := range []ColorSpace{ColorSpaceRGB, ColorSpaceLinearRGB, ColorSpaceHSL, ColorSpaceOKLab} {
+		for _, c := range []color.RGBA{red, green, white, black, magenta, {0x12, 0x34, 0x56, 0xff}} {
+			if l := rgba(LerpColor(c, black, 0, space)); l != c {
+				t.Errorf("%v: Incorrect start: %v, expected %v", space, l, c)
+			}
+			if l := rgba(LerpColor(black, c, 1, space)); l != c {
+				t.Errorf("%v: Incorrect end: %v, expected %v", space, l, c)
+			}
+		}
+		// Fading from transparent doesn't tint the color.
+		if l := rgba(LerpColor(nil, red, 0.5, space)); !similar(l, color.RGBA{0x80, 0, 0, 0x80}) {
+			t.Errorf("%v: Incorrect fade: %v", space, l)
+		}
+	}
+
+	cases := []struct {
+		space    ColorSpace
+		a, b     color.Color
+		expected color.RGBA
+	}{
+		{ColorSpaceRGB, red, green, color.RGBA{0x80, 0x80, 0, 0xff}},
+		// Linear RGB is brighter in the middle.
+		{ColorSpaceLinearRGB, red, green, color.RGBA{0xbc, 0xbc, 0, 0xff}},
+		{ColorSpaceLinearRGB, black, white, color.RGBA{0xbc, 0xbc, 0xbc, 0xff}},
+		// HSL goes the short way around the wheel, from 0° to 300° through 330°.
+		{ColorSpaceHSL, red, magenta, color.RGBA{0xff, 0, 0x80, 0xff}},
+		{ColorSpaceHSL, red, green, color.RGBA{0xff, 0xff, 0, 0xff}},
+		// Gray keeps the hue of the other color.
+		{ColorSpaceHSL, red, white, color.RGBA{0xdf, 0x9f, 0x9f, 0xff}},
+		// OKLab is perceptually even, so the middle of black and white is darker than in linear RGB.
+		{ColorSpaceOKLab, black, white, color.RGBA{0x63, 0x63, 0x63, 0xff}},
+	}
+	for _, i := range cases {
+		if l := rgba(LerpColor(i.a, i.b, 0.5, i.space)); l != i.expected {
+			t.Errorf("%v: LerpColor(%v, %v) = %v, expected %v", i.space, i.a, i.b, l, i.expected)
+		}
+	}
+
+	if c := (ColorLerp{Start: red, End: green, Space: ColorSpaceHSL}).Interpolate(0.5); rgba(c) != (color.RGBA{0xff, 0xff, 0, 0xff}) {
+		t.Errorf("Incorrect ColorLerp: %v", c)
+	}
+}
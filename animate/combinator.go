@@ -0,0 +1,292 @@
+package animate
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// forever is the duration of animations that repeat until they are cancelled.
+const forever = time.Duration(math.MaxInt64)
+
+// RepeatForever can be passed to Repeat to repeat an animation until it is cancelled.
+const RepeatForever = 0
+
+// velocityAt returns the velocity of a at t, or 0 if a is not a VelocityAnimation.
+func velocityAt(a Animation, t time.Duration) float64 {
+	if v, ok := a.(VelocityAnimation); ok {
+		return v.VelocityAt(t)
+	}
+	return 0
+}
+
+func addDurations(a, b time.Duration) time.Duration {
+	if a > forever-b {
+		return forever
+	}
+	return a + b
+}
+
+// Delay returns an animation that waits for d before running a. It holds the start of a while waiting.
+func Delay(a Animation, d time.Duration) Animation {
+	return &delay{animation: a, delay: d}
+}
+
+type delay struct {
+	animation Animation
+	delay     time.Duration
+}
+
+func (a *delay) Duration() time.Duration {
+	return addDurations(a.delay, a.animation.Duration())
+}
+
+func (a *delay) Tick(t time.Duration) float64 {
+	if t < a.delay {
+		return a.animation.Tick(0)
+	}
+	return a.animation.Tick(t - a.delay)
+}
+
+func (a *delay) VelocityAt(t time.Duration) float64 {
+	if t < a.delay {
+		return 0
+	}
+	return velocityAt(a.animation, t-a.delay)
+}
+
+// Reverse returns an animation that runs a backwards.
+func Reverse(a Animation) Animation {
+	return &reverse{animation: a}
+}
+
+type reverse struct {
+	animation Animation
+}
+
+func (a *reverse) Duration() time.Duration {
+	return a.animation.Duration()
+}
+
+func (a *reverse) Tick(t time.Duration) float64 {
+	d := a.animation.Duration()
+	if t > d {
+		t = d
+	}
+	return a.animation.Tick(d - t)
+}
+
+func (a *reverse) VelocityAt(t time.Duration) float64 {
+	d := a.animation.Duration()
+	if t >= d {
+		return 0
+	}
+	return -velocityAt(a.animation, d-t)
+}
+
+// Repeat returns an animation that runs a count times. If count is RepeatForever, it repeats until it is cancelled.
+//
+//	// Pulse until the view disappears.
+//	h := opacity.Run(animate.Repeat(animate.AutoReverse(&animate.Basic{Start: 1, End: 0.5, Dur: time.Second}), animate.RepeatForever))
+func Repeat(a Animation, count int) Animation {
+	return &repeat{animation: a, count: count}
+}
+
+type repeat struct {
+	animation Animation
+	count     int
+}
+
+func (a *repeat) Duration() time.Duration {
+	d := a.animation.Duration()
+	if a.count <= 0 {
+		return forever
+	}
+	if d > 0 && time.Duration(a.count) > forever/d {
+		return forever
+	}
+	return d * time.Duration(a.count)
+}
+
+func (a *repeat) local(t time.Duration) time.Duration {
+	d := a.animation.Duration()
+	if d <= 0 {
+		return 0
+	}
+	if t >= a.Duration() {
+		return d
+	}
+	return t % d
+}
+
+func (a *repeat) Tick(t time.Duration) float64 {
+	return a.animation.Tick(a.local(t))
+}
+
+func (a *repeat) VelocityAt(t time.Duration) float64 {
+	if t >= a.Duration() {
+		return 0
+	}
+	return velocityAt(a.animation, a.local(t))
+}
+
+// AutoReverse returns an animation that runs a forwards and then backwards.
+func AutoReverse(a Animation) Animation {
+	return Sequence(a, Reverse(a))
+}
+
+// Sequence returns an animation that runs each of as after the previous one ends.
+func Sequence(as ...Animation) Animation {
+	return &sequence{animations: as}
+}
+
+type sequence struct {
+	animations []Animation
+}
+
+func (a *sequence) Duration() time.Duration {
+	d := time.Duration(0)
+	for _, i := range a.animations {
+		d = addDurations(d, i.Duration())
+	}
+	return d
+}
+
+// at returns the animation that is running at t, and the time since it started.
+func (a *sequence) at(t time.Duration) (Animation, time.Duration) {
+	if len(a.animations) == 0 {
+		return nil, 0
+	}
+	for _, i := range a.animations {
+		d := i.Duration()
+		if t < d {
+			return i, t
+		}
+		t -= d
+	}
+	last := a.animations[len(a.animations)-1]
+	return last, last.Duration()
+}
+
+func (a *sequence) Tick(t time.Duration) float64 {
+	an, t := a.at(t)
+	if an == nil {
+		return 0
+	}
+	return an.Tick(t)
+}
+
+func (a *sequence) VelocityAt(t time.Duration) float64 {
+	an, t := a.at(t)
+	if an == nil || t >= an.Duration() {
+		return 0
+	}
+	return velocityAt(an, t)
+}
+
+// Timeline groups animations on several Values, so that they can be started and cancelled together. Each animation
+// starts at an offset from the start of the timeline.
+//
+//	t := &animate.Timeline{}
+//	t.Add(opacity, &animate.Basic{Start: 0, End: 1, Dur: time.Second / 4})
+//	t.AddAt(time.Second/8, offset, &animate.Spring{Start: 100, End: 0})
+//	h := t.Run()
+type Timeline struct {
+	tracks []*track
+}
+
+// Add adds an animation on v, starting at the beginning of t.
+func (t *Timeline) Add(v *Value, a Animation) {
+	t.AddAt(0, v, a)
+}
+
+// AddAt adds an animation on v, starting at offset. If v has several animations, each runs until the next one starts.
+// Before the first one starts, v holds its start value.
+func (t *Timeline) AddAt(offset time.Duration, v *Value, a Animation) {
+	for _, i := range t.tracks {
+		if i.value == v {
+			i.add(offset, a)
+			return
+		}
+	}
+	tr := &track{value: v}
+	tr.add(offset, a)
+	t.tracks = append(t.tracks, tr)
+}
+
+// Duration returns the time until all of the animations in t end.
+func (t *Timeline) Duration() time.Duration {
+	d := time.Duration(0)
+	for _, i := range t.tracks {
+		if id := i.Duration(); id > d {
+			d = id
+		}
+	}
+	return d
+}
+
+// Run runs the animations in t on their Values, cancelling any animations that were previously running on them. The
+// returned Handle cancels all of them, and ends when all of them have ended. It is finished if none of them were
+// cancelled.
+func (t *Timeline) Run() *Handle {
+	hs := make([]*Handle, 0, len(t.tracks))
+	for _, i := range t.tracks {
+		hs = append(hs, i.value.Run(i.copy()))
+	}
+	return groupHandle(hs)
+}
+
+// track is an animation of the segments of a timeline that run on value.
+type track struct {
+	value    *Value
+	segments []segment
+}
+
+type segment struct {
+	offset    time.Duration
+	animation Animation
+}
+
+func (t *track) add(offset time.Duration, a Animation) {
+	t.segments = append(t.segments, segment{offset: offset, animation: a})
+	sort.SliceStable(t.segments, func(i, j int) bool {
+		return t.segments[i].offset < t.segments[j].offset
+	})
+}
+
+func (t *track) copy() *track {
+	return &track{value: t.value, segments: append([]segment(nil), t.segments...)}
+}
+
+// at returns the segment that is running at t, and the time since it started.
+func (t *track) at(d time.Duration) (segment, time.Duration) {
+	s := t.segments[0]
+	for _, i := range t.segments[1:] {
+		if i.offset > d {
+			break
+		}
+		s = i
+	}
+	if d < s.offset {
+		return s, 0
+	}
+	return s, d - s.offset
+}
+
+func (t *track) Duration() time.Duration {
+	last := t.segments[len(t.segments)-1]
+	return addDurations(last.offset, last.animation.Duration())
+}
+
+func (t *track) Tick(d time.Duration) float64 {
+	s, d := t.at(d)
+	return s.animation.Tick(d)
+}
+
+func (t *track) VelocityAt(d time.Duration) float64 {
+	s, local := t.at(d)
+	if d < s.offset || local >= s.animation.Duration() {
+		return 0
+	}
+	return velocityAt(s.animation, local)
+}
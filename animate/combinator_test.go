@@ -0,0 +1,103 @@
+package animate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+type tickCase struct {
+	t     time.Duration
+	value float64
+}
+
+func checkTicks(t *testing.T, name string, a Animation, cases []tickCase) {
+	for _, i := range cases {
+		if v := a.Tick(i.t); math.Abs(v-i.value) > 1e-9 {
+			t.Errorf("%v: Tick(%v) = %v, expected %v", name, i.t, v, i.value)
+		}
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	basic := &Basic{Start: 0, End: 10, Dur: 10 * time.Second}
+
+	a := Delay(basic, time.Second)
+	if a.Duration() != 11*time.Second {
+		t.Errorf("Incorrect delay duration: %v", a.Duration())
+	}
+	checkTicks(t, "Delay", a, []tickCase{{0, 0}, {time.Second, 0}, {3 * time.Second, 2}, {20 * time.Second, 10}})
+
+	a = Reverse(basic)
+	checkTicks(t, "Reverse", a, []tickCase{{0, 10}, {2 * time.Second, 8}, {20 * time.Second, 0}})
+
+	a = Repeat(basic, 3)
+	if a.Duration() != 30*time.Second {
+		t.Errorf("Incorrect repeat duration: %v", a.Duration())
+	}
+	checkTicks(t, "Repeat", a, []tickCase{{2 * time.Second, 2}, {12 * time.Second, 2}, {29 * time.Second, 9}, {40 * time.Second, 10}})
+
+	a = Repeat(basic, RepeatForever)
+	if a.Duration() != forever {
+		t.Errorf("Expected to repeat forever: %v", a.Duration())
+	}
+	checkTicks(t, "RepeatForever", a, []tickCase{{1002 * time.Second, 2}})
+
+	a = AutoReverse(basic)
+	checkTicks(t, "AutoReverse", a, []tickCase{{2 * time.Second, 2}, {12 * time.Second, 8}, {20 * time.Second, 0}})
+
+	a = Sequence(basic, &Basic{Start: 100, End: 200, Dur: time.Second}, Delay(basic, time.Second))
+	if a.Duration() != 22*time.Second {
+		t.Errorf("Incorrect sequence duration: %v", a.Duration())
+	}
+	checkTicks(t, "Sequence", a, []tickCase{{5 * time.Second, 5}, {10500 * time.Millisecond, 150}, {11500 * time.Millisecond, 0}, {30 * time.Second, 10}})
+
+	if d := Sequence(Repeat(basic, RepeatForever), basic).Duration(); d != forever {
+		t.Errorf("Expected sequence to saturate: %v", d)
+	}
+	if v := Sequence().Tick(time.Second); v != 0 {
+		t.Errorf("Incorrect empty sequence: %v", v)
+	}
+}
+
+func TestCombinatorVelocity(t *testing.T) {
+	s := &Spring{Start: 0, End: 1, Velocity: 3}
+	if v := velocityAt(Delay(s, time.Second), time.Second); v != 3 {
+		t.Errorf("Incorrect delayed velocity: %v", v)
+	}
+	if v := velocityAt(Reverse(s), s.Duration()); v != 0 {
+		t.Errorf("Incorrect reversed velocity: %v", v)
+	}
+	if v := velocityAt(Reverse(s), 0); v != 0 {
+		t.Errorf("Expected a settled spring to be stationary: %v", v)
+	}
+	if v := velocityAt(Sequence(&Basic{Dur: time.Second}, s), time.Second); v != 3 {
+		t.Errorf("Incorrect sequence velocity: %v", v)
+	}
+	if v := velocityAt(Repeat(s, 2), s.Duration()); v != 3 {
+		t.Errorf("Incorrect repeat velocity: %v", v)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	a, b := &Value{}, &Value{}
+	tl := &Timeline{}
+	tl.Add(a, &Basic{Start: 0, End: 10, Dur: 10 * time.Second})
+	tl.AddAt(5*time.Second, a, &Basic{Start: 100, End: 200, Dur: 10 * time.Second})
+	tl.AddAt(2*time.Second, b, &Basic{Start: 1, End: 2, Dur: time.Second})
+	if tl.Duration() != 15*time.Second {
+		t.Errorf("Incorrect duration: %v", tl.Duration())
+	}
+
+	checkTicks(t, "Track a", tl.tracks[0], []tickCase{{4 * time.Second, 4}, {5 * time.Second, 100}, {10 * time.Second, 150}, {20 * time.Second, 200}})
+	checkTicks(t, "Track b", tl.tracks[1], []tickCase{{0, 1}, {2500 * time.Millisecond, 1.5}, {20 * time.Second, 2}})
+
+	h := tl.Run()
+	if a.animation == nil || b.animation == nil || !h.Running() {
+		t.Fatal("Expected animations to be running")
+	}
+	h.Cancel()
+	if a.animation != nil || b.animation != nil || !h.Cancelled() {
+		t.Error("Expected animations to be cancelled")
+	}
+}
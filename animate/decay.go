@@ -0,0 +1,229 @@
+package animate
+
+import (
+	"math"
+	"time"
+)
+
+// Deceleration rates for Decay. They are the fraction of velocity that remains after each millisecond.
+const (
+	DecelerationRateNormal = 0.998
+	DecelerationRateFast   = 0.99
+)
+
+// DefaultDecayTolerance is the distance from its projected end at which a Decay stops.
+const DefaultDecayTolerance = 0.1
+
+// Decay is an animation that starts at Start with an initial Velocity and gradually slows down, like a scroll view
+// that has been flicked. If Bounded is true and the value would leave the range between Min and Max, it overshoots and
+// springs back to the bound it crossed. If Start is already out of bounds, it springs back immediately.
+//
+//	value.Run(&animate.Decay{
+//		Start:    value.Value(),
+//		Velocity: velocity,
+//		Bounded:  true,
+//		Min:      0,
+//		Max:      contentHeight - viewHeight,
+//	})
+type Decay struct {
+	Start float64
+	// Velocity is the initial velocity in units per second.
+	Velocity float64
+	// Rate is the fraction of velocity that remains after each millisecond. If it is 0, DecelerationRateNormal is
+	// used.
+	Rate float64
+	// Tolerance is the distance from its projected end at which the decay stops. If it is 0, DefaultDecayTolerance is
+	// used.
+	Tolerance float64
+	Bounded   bool
+	Min       float64
+	Max       float64
+	// Bounce is the spring used to return to Min or Max. Only its Stiffness, Damping, Mass and Tolerance are used. If
+	// it is nil, a critically damped spring with DefaultSpringStiffness is used.
+	Bounce *Spring
+
+	plan  decayPlan
+	planP decayParams
+}
+
+type decayParams struct {
+	start, velocity, rate, tolerance float64
+	bounded                          bool
+	min, max                         float64
+	bounce                           Spring
+}
+
+// decayPlan decays until cross, and then runs spring.
+type decayPlan struct {
+	valid    bool
+	params   decayParams
+	k        float64 // Natural log of the rate per second.
+	cross    time.Duration
+	spring   *Spring
+	duration time.Duration
+}
+
+func (a *Decay) params() decayParams {
+	p := decayParams{
+		start:     a.Start,
+		velocity:  a.Velocity,
+		rate:      a.Rate,
+		tolerance: a.Tolerance,
+		bounded:   a.Bounded,
+		min:       a.Min,
+		max:       a.Max,
+	}
+	if p.rate <= 0 || p.rate >= 1 {
+		p.rate = DecelerationRateNormal
+	}
+	if p.tolerance <= 0 {
+		p.tolerance = DefaultDecayTolerance
+	}
+	if a.Bounce != nil {
+		p.bounce = Spring{
+			Stiffness: a.Bounce.Stiffness,
+			Damping:   a.Bounce.Damping,
+			Mass:      a.Bounce.Mass,
+			Tolerance: a.Bounce.Tolerance,
+		}
+	} else {
+		p.bounce = Spring{Stiffness: DefaultSpringStiffness, Damping: 2 * math.Sqrt(DefaultSpringStiffness)}
+	}
+	if p.max < p.min {
+		p.min, p.max = p.max, p.min
+	}
+	return p
+}
+
+func (a *Decay) getPlan() *decayPlan {
+	p := a.params()
+	if a.plan.valid && a.planP == p {
+		return &a.plan
+	}
+
+	plan := decayPlan{valid: true, params: p, k: 1000 * math.Log(p.rate), cross: -1}
+	if p.bounded && (p.start < p.min || p.start > p.max) {
+		plan.cross = 0
+		plan.spring = p.bounce.withMotion(p.start, clamp(p.start, p.min, p.max), p.velocity)
+		plan.duration = plan.spring.Duration()
+	} else if end := plan.decayEnd(); p.bounded && (end < p.min || end > p.max) {
+		bound := clamp(end, p.min, p.max)
+		// Solve start + velocity*(e^kt-1)/k = bound for t.
+		t := math.Log(1+(bound-p.start)*plan.k/p.velocity) / plan.k
+		plan.cross = time.Duration(t * float64(time.Second))
+		plan.spring = p.bounce.withMotion(bound, bound, p.velocity*math.Exp(plan.k*t))
+		plan.duration = plan.cross + plan.spring.Duration()
+	} else if remaining := math.Abs(p.velocity / plan.k); remaining > p.tolerance {
+		// Solve remaining*e^kt = tolerance for t.
+		t := math.Log(p.tolerance/remaining) / plan.k
+		plan.duration = time.Duration(math.Ceil(t * float64(time.Second)))
+	}
+
+	a.plan = plan
+	a.planP = p
+	return &a.plan
+}
+
+// decayEnd returns the position that the decay comes to rest at, ignoring bounds.
+func (p *decayPlan) decayEnd() float64 {
+	return p.params.start - p.params.velocity/p.k
+}
+
+func (p *decayPlan) at(t time.Duration) (x, v float64) {
+	if p.spring != nil && t >= p.cross {
+		t -= p.cross
+		return p.spring.Tick(t), p.spring.VelocityAt(t)
+	}
+	e := math.Exp(p.k * t.Seconds())
+	return p.params.start + p.params.velocity*(e-1)/p.k, p.params.velocity * e
+}
+
+func (s Spring) withMotion(start, end, velocity float64) *Spring {
+	s.Start = start
+	s.End = end
+	s.Velocity = velocity
+	return &s
+}
+
+// End returns the position that a comes to rest at.
+func (a *Decay) End() float64 {
+	p := a.getPlan()
+	if p.spring != nil {
+		return p.spring.End
+	}
+	return p.decayEnd()
+}
+
+// Duration implements the Animation interface.
+func (a *Decay) Duration() time.Duration {
+	return a.getPlan().duration
+}
+
+// Tick implements the Animation interface.
+func (a *Decay) Tick(t time.Duration) float64 {
+	p := a.getPlan()
+	if t >= p.duration {
+		return a.End()
+	}
+	x, _ := p.at(t)
+	return x
+}
+
+// VelocityAt implements the VelocityAnimation interface.
+func (a *Decay) VelocityAt(t time.Duration) float64 {
+	p := a.getPlan()
+	if t >= p.duration {
+		return 0
+	}
+	_, v := p.at(t)
+	return v
+}
+
+// Project returns the position that a value at position moving at velocity units per second comes to rest at, if it
+// decelerates at rate. If rate is 0, DecelerationRateNormal is used. It is useful for choosing where to snap to when a
+// gesture ends.
+//
+//	end := animate.Project(offset.Value(), velocity, 0)
+//	offset.Run(&animate.Spring{Start: offset.Value(), End: animate.Nearest(end, pages...), Velocity: velocity})
+func Project(position, velocity, rate float64) float64 {
+	d := &Decay{Start: position, Velocity: velocity, Rate: rate}
+	return d.End()
+}
+
+// Nearest returns the point closest to x, or x if there are no points.
+func Nearest(x float64, points ...float64) float64 {
+	nearest := x
+	for i, p := range points {
+		if i == 0 || math.Abs(p-x) < math.Abs(nearest-x) {
+			nearest = p
+		}
+	}
+	return nearest
+}
+
+// RubberBand returns how far a value dragged offset units past its bounds should be displayed past them, so that it
+// resists being dragged further. Dimension is the size of the view being dragged, which limits the result, and
+// coefficient controls the resistance. If coefficient is 0, 0.55 is used.
+func RubberBand(offset, dimension, coefficient float64) float64 {
+	if dimension <= 0 {
+		return 0
+	}
+	if coefficient == 0 {
+		coefficient = 0.55
+	}
+	sign := 1.0
+	if offset < 0 {
+		sign, offset = -1, -offset
+	}
+	return sign * (1 - 1/(offset*coefficient/dimension+1)) * dimension
+}
+
+func clamp(x, min, max float64) float64 {
+	if x < min {
+		return min
+	}
+	if x > max {
+		return max
+	}
+	return x
+}
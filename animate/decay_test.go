@@ -0,0 +1,107 @@
+package animate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecay(t *testing.T) {
+	a := &Decay{Start: 10, Velocity: 1000}
+	// A velocity of 1000 units per second that keeps 0.998 of itself each millisecond travels about 500 units.
+	if end := a.End(); math.Abs(end-10-1000/(-1000*math.Log(0.998))) > 1e-9 || math.Abs(end-509.5) > 1 {
+		t.Errorf("Incorrect end: %v", end)
+	}
+	if v := a.Tick(0); v != 10 {
+		t.Errorf("Tick(0) = %v", v)
+	}
+	if v := a.Tick(a.Duration()); v != a.End() {
+		t.Errorf("Tick(Duration) = %v, expected %v", v, a.End())
+	}
+	if v, _ := a.getPlan().at(a.Duration()); math.Abs(v-a.End()) > DefaultDecayTolerance {
+		t.Errorf("Decay should be within tolerance when it stops: %v", v)
+	}
+	if v := a.VelocityAt(0); v != 1000 {
+		t.Errorf("VelocityAt(0) = %v", v)
+	}
+	prev := 0.0
+	for i := time.Duration(0); i < a.Duration(); i += 10 * time.Millisecond {
+		v := a.Tick(i)
+		if v < prev {
+			t.Errorf("Decay moved backwards at %v", i)
+		}
+		prev = v
+	}
+
+	if d := (&Decay{Start: 10}).Duration(); d != 0 {
+		t.Errorf("Expected a stationary decay to stop immediately: %v", d)
+	}
+	if end := (&Decay{Velocity: -1000, Rate: DecelerationRateFast}).End(); end > -99 || end < -100 {
+		t.Errorf("Incorrect fast end: %v", end)
+	}
+}
+
+func TestDecayBounded(t *testing.T) {
+	a := &Decay{Start: 0, Velocity: 1000, Bounded: true, Min: 0, Max: 100}
+	if end := a.End(); end != 100 {
+		t.Errorf("Expected to rest at the bound: %v", end)
+	}
+	max := 0.0
+	for i := time.Duration(0); i < a.Duration(); i += time.Millisecond {
+		max = math.Max(max, a.Tick(i))
+	}
+	if max <= 100 || max > 200 {
+		t.Errorf("Expected to overshoot the bound: %v", max)
+	}
+	if v := a.Tick(a.Duration()); v != 100 {
+		t.Errorf("Tick(Duration) = %v", v)
+	}
+
+	// The velocity is continuous when crossing the bound.
+	p := a.getPlan()
+	before, after := a.VelocityAt(p.cross-time.Microsecond), a.VelocityAt(p.cross)
+	if math.Abs(before-after) > 1 {
+		t.Errorf("Velocity is discontinuous: %v %v", before, after)
+	}
+
+	// Out of bounds values spring back immediately.
+	a = &Decay{Start: -50, Bounded: true, Min: 0, Max: 100}
+	if a.End() != 0 || a.Tick(0) != -50 || a.Tick(a.Duration()/2) <= -50 {
+		t.Errorf("Expected to spring back into bounds: %v %v", a.End(), a.Tick(a.Duration()/2))
+	}
+
+	// Values that stay in bounds decay normally.
+	a = &Decay{Start: 0, Velocity: 100, Bounded: true, Min: 0, Max: 100}
+	if a.End() != Project(0, 100, 0) {
+		t.Errorf("Incorrect end: %v", a.End())
+	}
+}
+
+func TestNearest(t *testing.T) {
+	if v := Nearest(40, 0, 100, 200); v != 0 {
+		t.Errorf("Incorrect nearest: %v", v)
+	}
+	if v := Nearest(Project(40, 500, 0), 0, 100, 200); v != 200 {
+		t.Errorf("Incorrect projected nearest: %v", v)
+	}
+	if v := Nearest(40); v != 40 {
+		t.Errorf("Incorrect nearest: %v", v)
+	}
+}
+
+func TestRubberBand(t *testing.T) {
+	if v := RubberBand(0, 100, 0); v != 0 {
+		t.Errorf("RubberBand(0) = %v", v)
+	}
+	prev := 0.0
+	for _, offset := range []float64{10, 100, 1000, 100000} {
+		v := RubberBand(offset, 100, 0)
+		if v <= prev || v >= offset || v >= 100 {
+			t.Errorf("RubberBand(%v) = %v", offset, v)
+		}
+		if RubberBand(-offset, 100, 0) != -v {
+			t.Errorf("RubberBand(%v) is not symmetric", -offset)
+		}
+		prev = v
+	}
+}
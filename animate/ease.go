@@ -0,0 +1,295 @@
+package animate
+
+import (
+	"math"
+	"sort"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+)
+
+// EaseMode is the part of an easing curve that is used.
+type EaseMode int
+
+const (
+	// EaseIn starts slowly.
+	EaseIn EaseMode = iota
+	// EaseOut ends slowly. It is EaseIn rotated by 180°.
+	EaseOut
+	// EaseInOut starts and ends slowly, using EaseIn for the first half and EaseOut for the second.
+	EaseInOut
+)
+
+// apply eases a with the curve in, which starts slowly, according to m.
+func (m EaseMode) apply(in func(float64) float64, a float64) float64 {
+	switch m {
+	case EaseOut:
+		return 1 - in(1-a)
+	case EaseInOut:
+		if a < 0.5 {
+			return in(2*a) / 2
+		}
+		return 1 - in(2-2*a)/2
+	default:
+		return in(a)
+	}
+}
+
+// SineEase eases along a quarter of a sine wave.
+type SineEase struct {
+	Mode EaseMode
+}
+
+// Interpolate implements the Interpolater interface.
+func (e SineEase) Interpolate(a float64) float64 {
+	return e.Mode.apply(func(t float64) float64 {
+		return 1 - math.Cos(t*math.Pi/2)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e SineEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// ExpoEase eases exponentially, doubling every tenth of the animation.
+type ExpoEase struct {
+	Mode EaseMode
+}
+
+// Interpolate implements the Interpolater interface.
+func (e ExpoEase) Interpolate(a float64) float64 {
+	return e.Mode.apply(func(t float64) float64 {
+		if t <= 0 {
+			return 0
+		}
+		return math.Pow(2, 10*t-10)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e ExpoEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// CircEase eases along a quarter of a circle.
+type CircEase struct {
+	Mode EaseMode
+}
+
+// Interpolate implements the Interpolater interface.
+func (e CircEase) Interpolate(a float64) float64 {
+	return e.Mode.apply(func(t float64) float64 {
+		if t >= 1 {
+			return 1
+		}
+		return 1 - math.Sqrt(1-t*t)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e CircEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// DefaultBackOvershoot is the overshoot used by BackEase when Overshoot is 0. It overshoots by 10%.
+const DefaultBackOvershoot = 1.70158
+
+// BackEase pulls back before moving towards the end, overshooting it with EaseOut.
+type BackEase struct {
+	Mode EaseMode
+	// Overshoot controls how far the curve pulls back. If it is 0, DefaultBackOvershoot is used.
+	Overshoot float64
+}
+
+// Interpolate implements the Interpolater interface.
+func (e BackEase) Interpolate(a float64) float64 {
+	s := e.Overshoot
+	if s == 0 {
+		s = DefaultBackOvershoot
+	}
+	return e.Mode.apply(func(t float64) float64 {
+		return t * t * ((s+1)*t - s)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e BackEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// ElasticEase oscillates with growing amplitude, like a stretched elastic band. With EaseOut, it overshoots the end
+// and oscillates around it.
+type ElasticEase struct {
+	Mode EaseMode
+	// Amplitude is the size of the largest oscillation. If it is less than 1, 1 is used.
+	Amplitude float64
+	// Period is the length of each oscillation as a fraction of the animation. If it is 0, 0.3 is used.
+	Period float64
+}
+
+// Interpolate implements the Interpolater interface.
+func (e ElasticEase) Interpolate(a float64) float64 {
+	amp, period := e.Amplitude, e.Period
+	if amp < 1 {
+		amp = 1
+	}
+	if period <= 0 {
+		period = 0.3
+	}
+	s := period / (2 * math.Pi) * math.Asin(1/amp)
+	return e.Mode.apply(func(t float64) float64 {
+		if t <= 0 || t >= 1 {
+			return t
+		}
+		return -amp * math.Pow(2, 10*(t-1)) * math.Sin((t-1-s)*2*math.Pi/period)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e ElasticEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// BounceEase bounces against the end, like a dropped ball. With EaseIn, it bounces against the start.
+type BounceEase struct {
+	Mode EaseMode
+}
+
+// Interpolate implements the Interpolater interface.
+func (e BounceEase) Interpolate(a float64) float64 {
+	return e.Mode.apply(func(t float64) float64 {
+		return 1 - bounceOut(1-t)
+	}, a)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e BounceEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+func bounceOut(t float64) float64 {
+	const n, d = 7.5625, 2.75
+	switch {
+	case t < 1/d:
+		return n * t * t
+	case t < 2/d:
+		t -= 1.5 / d
+		return n*t*t + 0.75
+	case t < 2.5/d:
+		t -= 2.25 / d
+		return n*t*t + 0.9375
+	default:
+		t -= 2.625 / d
+		return n*t*t + 0.984375
+	}
+}
+
+// StepPosition is where the jumps of a StepsEase happen, matching CSS's steps() function.
+type StepPosition int
+
+const (
+	// StepJumpEnd holds each step until the end of its interval, so the end is only reached when the animation ends.
+	StepJumpEnd StepPosition = iota
+	// StepJumpStart jumps at the start of each interval, so the start is left immediately.
+	StepJumpStart
+	// StepJumpNone holds both the start and the end for an interval.
+	StepJumpNone
+	// StepJumpBoth jumps at both the start and the end, so neither is held.
+	StepJumpBoth
+)
+
+// StepsEase jumps between Steps evenly spaced values instead of moving smoothly.
+type StepsEase struct {
+	Steps    int
+	Position StepPosition
+}
+
+// Interpolate implements the Interpolater interface.
+func (e StepsEase) Interpolate(a float64) float64 {
+	if a < 0 {
+		return 0
+	}
+	if a >= 1 {
+		return 1
+	}
+	steps := e.Steps
+	if steps < 1 {
+		steps = 1
+	}
+	step := math.Floor(a * float64(steps))
+	if e.Position == StepJumpStart || e.Position == StepJumpBoth {
+		step += 1
+	}
+	return step / float64(e.jumps())
+}
+
+// jumps returns the number of intervals that the output is divided into.
+func (e StepsEase) jumps() int {
+	steps := e.Steps
+	if steps < 1 {
+		steps = 1
+	}
+	switch e.Position {
+	case StepJumpNone:
+		if steps == 1 {
+			return 1
+		}
+		return steps - 1
+	case StepJumpBoth:
+		return steps + 1
+	default:
+		return steps
+	}
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e StepsEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// CurveEase interpolates linearly between Points, which should be in order of X. It holds the first point's Y before
+// it, and the last point's Y after it. If there are no points, it is linear.
+//
+//	// Move quickly, pause, and finish.
+//	ease := animate.CurveEase{Points: []layout.Point{layout.Pt(0, 0), layout.Pt(0.3, 0.6), layout.Pt(0.7, 0.6), layout.Pt(1, 1)}}
+type CurveEase struct {
+	Points []layout.Point
+}
+
+// Interpolate implements the Interpolater interface.
+func (e CurveEase) Interpolate(a float64) float64 {
+	if len(e.Points) == 0 {
+		return a
+	}
+	i := sort.Search(len(e.Points), func(i int) bool {
+		return e.Points[i].X > a
+	})
+	if i == 0 {
+		return e.Points[0].Y
+	}
+	if i == len(e.Points) {
+		return e.Points[i-1].Y
+	}
+	p, q := e.Points[i-1], e.Points[i]
+	return p.Y + (q.Y-p.Y)*(a-p.X)/(q.X-p.X)
+}
+
+// Notifier is a convenience method around animate.FloatInterpolate(n, e)
+func (e CurveEase) Notifier(a comm.Float64Notifier) comm.Float64Notifier {
+	return FloatInterpolate(a, e)
+}
+
+// SampleEase returns a CurveEase that approximates e with n+1 evenly spaced samples. It can be used to cache easings
+// that are expensive to compute.
+func SampleEase(e FloatInterpolater, n int) CurveEase {
+	if n < 1 {
+		n = 1
+	}
+	points := make([]layout.Point, n+1)
+	for i := range points {
+		x := float64(i) / float64(n)
+		points[i] = layout.Pt(x, e.Interpolate(x))
+	}
+	return CurveEase{Points: points}
+}
@@ -0,0 +1,155 @@
+package animate
+
+import (
+	"math"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+type easeCase struct {
+	in, out float64
+}
+
+func checkEase(t *testing.T, name string, e FloatInterpolater, cases []easeCase) {
+	for _, i := range cases {
+		if v := e.Interpolate(i.in); math.Abs(v-i.out) > 1e-4 {
+			t.Errorf("%v: Interpolate(%v) = %v, expected %v", name, i.in, v, i.out)
+		}
+	}
+}
+
+func TestEaseEndpoints(t *testing.T) {
+	eases := map[string]FloatInterpolater{
+		"Linear":    LinearEase{},
+		"Default":   DefaultEase,
+		"In":        DefaultInEase,
+		"Out":       DefaultOutEase,
+		"InOut":     DefaultInOutEase,
+		"Overshoot": CubicBezierEase{0.3, -0.5, 0.7, 1.5},
+		"Steps":     StepsEase{Steps: 4},
+		"Curve":     CurveEase{Points: []layout.Point{layout.Pt(0, 0), layout.Pt(0.5, 0.8), layout.Pt(1, 1)}},
+		"Sampled":   SampleEase(BounceEase{}, 10),
+	}
+	for _, mode := range []EaseMode{EaseIn, EaseOut, EaseInOut} {
+		for name, e := range map[string]FloatInterpolater{
+			"Sine":    SineEase{Mode: mode},
+			"Expo":    ExpoEase{Mode: mode},
+			"Circ":    CircEase{Mode: mode},
+			"Back":    BackEase{Mode: mode},
+			"Elastic": ElasticEase{Mode: mode},
+			"Bounce":  BounceEase{Mode: mode},
+			"Poly":    PolyInOutEase{ExpIn: 3, ExpOut: 3},
+		} {
+			eases[name+string('0'+rune(mode))] = e
+		}
+	}
+	for name, e := range eases {
+		checkEase(t, name, e, []easeCase{{0, 0}, {1, 1}})
+	}
+}
+
+func TestEaseModes(t *testing.T) {
+	for _, e := range []struct {
+		in, out, inOut FloatInterpolater
+	}{
+		{SineEase{EaseIn}, SineEase{EaseOut}, SineEase{EaseInOut}},
+		{ExpoEase{EaseIn}, ExpoEase{EaseOut}, ExpoEase{EaseInOut}},
+		{CircEase{EaseIn}, CircEase{EaseOut}, CircEase{EaseInOut}},
+		{BackEase{Mode: EaseIn}, BackEase{Mode: EaseOut}, BackEase{Mode: EaseInOut}},
+		{ElasticEase{Mode: EaseIn}, ElasticEase{Mode: EaseOut}, ElasticEase{Mode: EaseInOut}},
+		{BounceEase{EaseIn}, BounceEase{EaseOut}, BounceEase{EaseInOut}},
+	} {
+		for _, a := range []float64{0.1, 0.25, 0.4, 0.6, 0.9} {
+			// Out is In rotated by 180°.
+			if in, out := e.in.Interpolate(a), e.out.Interpolate(1-a); math.Abs(in+out-1) > 1e-9 {
+				t.Errorf("%T: Out(%v) = %v, expected 1-%v", e.in, 1-a, out, in)
+			}
+			// InOut is symmetric around the center.
+			if v, w := e.inOut.Interpolate(a), e.inOut.Interpolate(1-a); math.Abs(v+w-1) > 1e-9 {
+				t.Errorf("%T: InOut is not symmetric at %v: %v %v", e.in, a, v, w)
+			}
+		}
+		if v := e.inOut.Interpolate(0.5); math.Abs(v-0.5) > 1e-9 {
+			t.Errorf("%T: InOut(0.5) = %v", e.in, v)
+		}
+	}
+}
+
+func TestEases(t *testing.T) {
+	checkEase(t, "Sine", SineEase{}, []easeCase{{0.5, 1 - math.Sqrt2/2}})
+	checkEase(t, "Expo", ExpoEase{}, []easeCase{{0.5, 1.0 / 32}, {0.9, 0.5}})
+	checkEase(t, "Circ", CircEase{}, []easeCase{{0.6, 0.2}})
+	checkEase(t, "Back", BackEase{}, []easeCase{{0.5, -0.0876975}})
+	checkEase(t, "BackOvershoot", BackEase{Overshoot: 2}, []easeCase{{0.5, -0.125}})
+	// Elastic ends each oscillation at an integer number of periods before the end.
+	checkEase(t, "Elastic", ElasticEase{}, []easeCase{{0.775, 0}, {0.85, -math.Pow(2, -1.5)}})
+	checkEase(t, "ElasticAmplitude", ElasticEase{Amplitude: 2, Period: 0.4}, []easeCase{{1 - 0.4/12, -math.Pow(2, -1/3.0) * 2 * math.Sin(-math.Pi/6-math.Asin(0.5))}})
+	checkEase(t, "Bounce", BounceEase{Mode: EaseOut}, []easeCase{{1 / 2.75, 1}, {2 / 2.75, 1}, {1.5 / 2.75, 0.75}})
+
+	// Back and elastic leave the range between 0-1.
+	if v := (BackEase{Mode: EaseOut}).Interpolate(0.7); v <= 1 {
+		t.Errorf("Expected BackEase to overshoot: %v", v)
+	}
+	if v := (ElasticEase{}).Interpolate(0.85); v >= 0 {
+		t.Errorf("Expected ElasticEase to undershoot: %v", v)
+	}
+	for a := 0.0; a <= 1; a += 0.01 {
+		if v := (BounceEase{}).Interpolate(a); v < -1e-9 || v > 1+1e-9 {
+			t.Errorf("Expected BounceEase to stay in range: %v", v)
+		}
+	}
+}
+
+func TestStepsEase(t *testing.T) {
+	checkEase(t, "End", StepsEase{Steps: 4}, []easeCase{{-1, 0}, {0, 0}, {0.24, 0}, {0.25, 0.25}, {0.99, 0.75}, {1, 1}})
+	checkEase(t, "Start", StepsEase{Steps: 4, Position: StepJumpStart}, []easeCase{{0, 0.25}, {0.24, 0.25}, {0.25, 0.5}, {0.99, 1}, {1, 1}})
+	checkEase(t, "None", StepsEase{Steps: 5, Position: StepJumpNone}, []easeCase{{0, 0}, {0.19, 0}, {0.2, 0.25}, {0.8, 1}, {1, 1}})
+	checkEase(t, "Both", StepsEase{Steps: 3, Position: StepJumpBoth}, []easeCase{{0, 0.25}, {0.34, 0.5}, {0.67, 0.75}, {1, 1}})
+	checkEase(t, "Zero", StepsEase{}, []easeCase{{0.5, 0}, {1, 1}})
+}
+
+func TestCurveEase(t *testing.T) {
+	e := CurveEase{Points: []layout.Point{layout.Pt(0.2, 0.1), layout.Pt(0.5, 0.7), layout.Pt(0.5, 0.8), layout.Pt(0.9, 1)}}
+	checkEase(t, "Curve", e, []easeCase{{0, 0.1}, {0.2, 0.1}, {0.35, 0.4}, {0.5, 0.8}, {0.7, 0.9}, {1, 1}})
+	checkEase(t, "Empty", CurveEase{}, []easeCase{{0.3, 0.3}})
+
+	s := SampleEase(SineEase{Mode: EaseInOut}, 100)
+	if len(s.Points) != 101 {
+		t.Errorf("Incorrect number of samples: %v", len(s.Points))
+	}
+	for a := 0.0; a <= 1; a += 0.037 {
+		if v, w := s.Interpolate(a), (SineEase{Mode: EaseInOut}).Interpolate(a); math.Abs(v-w) > 1e-3 {
+			t.Errorf("Incorrect sample at %v: %v, expected %v", a, v, w)
+		}
+	}
+}
+
+func TestCubicBezierEase(t *testing.T) {
+	// A curve with control points on the diagonal is linear.
+	checkEase(t, "Linear", CubicBezierEase{1.0 / 3, 1.0 / 3, 2.0 / 3, 2.0 / 3}, []easeCase{{0.1, 0.1}, {0.5, 0.5}, {0.77, 0.77}})
+	// Symmetric curves pass through the center.
+	checkEase(t, "InOut", DefaultInOutEase, []easeCase{{0.5, 0.5}, {-1, 0}, {2, 1}})
+
+	for _, e := range []CubicBezierEase{DefaultEase.(CubicBezierEase), DefaultInEase.(CubicBezierEase), {0, 1, 1, 0}, {0.9, 0.1, 0.1, 0.9}, {0.99, 0, 0.01, 1}} {
+		for _, eps := range []float64{1e-2, 1e-6, 1e-10} {
+			for x := 0.0; x <= 1; x += 0.01 {
+				y := e.Solve(x, eps)
+				// Check against the curve's parameter found by bisection.
+				lo, hi := 0.0, 1.0
+				for i := 0; i < 100; i++ {
+					if bezier(e.X0, e.X1, (lo+hi)/2) < x {
+						lo = (lo + hi) / 2
+					} else {
+						hi = (lo + hi) / 2
+					}
+				}
+				expected := bezier(e.Y0, e.Y1, lo)
+				// The error in y is bounded by the error in x times the curve's slope.
+				if math.Abs(y-expected) > eps*1000 {
+					t.Errorf("%v: Solve(%v, %v) = %v, expected %v", e, x, eps, y, expected)
+				}
+			}
+		}
+	}
+}
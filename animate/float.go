@@ -3,7 +3,6 @@ package animate
 import (
 	"math"
 
-	"golang.org/x/mobile/exp/sprite/clock" // TODO(KD): remove dependency
 	"gomatcha.io/matcha/comm"
 )
 
@@ -49,11 +48,72 @@ type CubicBezierEase struct {
 	X0, Y0, X1, Y1 float64
 }
 
+// DefaultBezierPrecision is the precision that CubicBezierEase solves curves to.
+const DefaultBezierPrecision = 1e-6
+
 // Interpolate implements the Interpolater interface.
 func (e CubicBezierEase) Interpolate(a float64) float64 {
-	f := clock.CubicBezier(float32(e.X0), float32(e.Y0), float32(e.X1), float32(e.Y1))
-	t := f(0, 100000, clock.Time(a*100000))
-	return float64(t)
+	return e.Solve(a, DefaultBezierPrecision)
+}
+
+// Solve returns the y coordinate of the curve at x, finding the curve's parameter to within epsilon of x. X is clamped
+// between 0-1.
+func (e CubicBezierEase) Solve(x, epsilon float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	if epsilon <= 0 {
+		epsilon = DefaultBezierPrecision
+	}
+
+	// Newton's method converges quickly for most curves.
+	t := x
+	for i := 0; i < 8; i++ {
+		dx := bezier(e.X0, e.X1, t) - x
+		if math.Abs(dx) < epsilon {
+			return bezier(e.Y0, e.Y1, t)
+		}
+		d := bezierSlope(e.X0, e.X1, t)
+		if math.Abs(d) < 1e-9 {
+			break
+		}
+		t -= dx / d
+		if t < 0 || t > 1 {
+			break
+		}
+	}
+
+	// Fall back to bisection, which always converges since x is monotonic when X0 and X1 are between 0-1.
+	lo, hi := 0.0, 1.0
+	t = x
+	for hi-lo > epsilon/2 {
+		v := bezier(e.X0, e.X1, t)
+		if math.Abs(v-x) < epsilon {
+			break
+		}
+		if v < x {
+			lo = t
+		} else {
+			hi = t
+		}
+		t = (lo + hi) / 2
+	}
+	return bezier(e.Y0, e.Y1, t)
+}
+
+// bezier returns a coordinate of the cubic Bézier curve from 0 to 1 with control coordinates p1 and p2 at t.
+func bezier(p1, p2, t float64) float64 {
+	u := 1 - t
+	return 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t
+}
+
+// bezierSlope returns the derivative of bezier with respect to t.
+func bezierSlope(p1, p2, t float64) float64 {
+	u := 1 - t
+	return 3*u*u*p1 + 6*u*t*(p2-p1) + 3*t*t*(1-p2)
 }
 
 // Notifier is a convenience method around animate.FloatInterpolate(n, e).
@@ -0,0 +1,101 @@
+package animate
+
+// Handle represents an animation that has been started. Its methods, other than Done, should only be called while
+// matcha.MainLocker is held.
+type Handle struct {
+	ended    bool
+	finished bool
+	done     chan struct{}
+	funcs    []func(finished bool)
+	cancel   func()
+}
+
+func newHandle(cancel func()) *Handle {
+	return &Handle{done: make(chan struct{}), cancel: cancel}
+}
+
+// Cancel stops the animation if it is still running.
+func (h *Handle) Cancel() {
+	if !h.ended && h.cancel != nil {
+		h.cancel()
+	}
+}
+
+// Running returns true if the animation has neither finished nor been cancelled.
+func (h *Handle) Running() bool {
+	return !h.ended
+}
+
+// Finished returns true if the animation ran to its end.
+func (h *Handle) Finished() bool {
+	return h.finished
+}
+
+// Cancelled returns true if the animation was cancelled before its end, either by Cancel or by another animation
+// replacing it.
+func (h *Handle) Cancelled() bool {
+	return h.ended && !h.finished
+}
+
+// OnComplete adds a function that is called with matcha.MainLocker held when the animation ends. Finished is true if
+// the animation ran to its end, and false if it was cancelled. If the animation has already ended, f is called
+// immediately.
+//
+//	h := value.Run(&animate.Basic{End: 1, Dur: time.Second})
+//	h.OnComplete(func(finished bool) {
+//		if finished {
+//			v.Signal()
+//		}
+//	})
+func (h *Handle) OnComplete(f func(finished bool)) {
+	if h.ended {
+		f(h.finished)
+		return
+	}
+	h.funcs = append(h.funcs, f)
+}
+
+// Done returns a channel that is closed when the animation ends. It may be used from any goroutine.
+//
+//	<-value.Run(a).Done()
+func (h *Handle) Done() <-chan struct{} {
+	return h.done
+}
+
+func (h *Handle) end(finished bool) {
+	if h.ended {
+		return
+	}
+	h.ended = true
+	h.finished = finished
+	funcs := h.funcs
+	h.funcs = nil
+	for _, f := range funcs {
+		f(finished)
+	}
+	close(h.done)
+}
+
+// groupHandle returns a handle that ends when all of hs have ended, and finishes if all of them finished. Cancelling
+// it cancels all of hs.
+func groupHandle(hs []*Handle) *Handle {
+	h := newHandle(func() {
+		for _, i := range hs {
+			i.Cancel()
+		}
+	})
+	remaining, finished := len(hs), true
+	if remaining == 0 {
+		h.end(true)
+	}
+	for _, i := range hs {
+		i.OnComplete(func(f bool) {
+			finished = finished && f
+			remaining -= 1
+			if remaining == 0 {
+				h.end(finished)
+			}
+		})
+	}
+	return h
+}
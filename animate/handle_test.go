@@ -0,0 +1,79 @@
+package animate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHandle(t *testing.T) {
+	clock := NewManualClock()
+	SetClock(clock)
+	defer SetClock(nil)
+
+	v := &Value{}
+	first := v.Run(&Basic{End: 1, Dur: time.Hour})
+	calls := []bool{}
+	first.OnComplete(func(finished bool) {
+		calls = append(calls, finished)
+	})
+	if !first.Running() || first.Finished() || first.Cancelled() {
+		t.Error("Expected the animation to be running")
+	}
+
+	// Running another animation cancels the first.
+	second := v.Run(&Basic{End: 1, Dur: time.Millisecond})
+	if !first.Cancelled() || first.Finished() || len(calls) != 1 || calls[0] {
+		t.Errorf("Expected the animation to be cancelled: %v", calls)
+	}
+	select {
+	case <-first.Done():
+	default:
+		t.Error("Expected Done to be closed")
+	}
+	first.Cancel()
+	first.OnComplete(func(finished bool) {
+		calls = append(calls, finished)
+	})
+	if len(calls) != 2 || calls[1] {
+		t.Errorf("Expected callbacks to be called immediately after the animation ends: %v", calls)
+	}
+
+	clock.Step(2 * time.Millisecond)
+	select {
+	case <-second.Done():
+	default:
+		t.Fatal("Expected the animation to finish")
+	}
+	if !second.Finished() || second.Cancelled() || second.Running() {
+		t.Error("Expected the animation to finish")
+	}
+	if v.Value() != 1 {
+		t.Errorf("Incorrect value: %v", v.Value())
+	}
+}
+
+func TestGroupHandle(t *testing.T) {
+	a, b := newHandle(nil), newHandle(nil)
+	g := groupHandle([]*Handle{a, b})
+	a.end(true)
+	if !g.Running() {
+		t.Error("Expected the group to be running")
+	}
+	b.end(true)
+	if !g.Finished() {
+		t.Error("Expected the group to finish")
+	}
+
+	a, b = newHandle(nil), newHandle(nil)
+	a.cancel = func() { a.end(false) }
+	g = groupHandle([]*Handle{a, b})
+	g.Cancel()
+	b.end(true)
+	if !g.Cancelled() {
+		t.Error("Expected the group to be cancelled")
+	}
+
+	if !groupHandle(nil).Finished() {
+		t.Error("Expected an empty group to finish")
+	}
+}
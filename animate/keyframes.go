@@ -0,0 +1,85 @@
+package animate
+
+import (
+	"math"
+	"time"
+)
+
+// Keyframe is a value that a Keyframes animation passes through at Time.
+type Keyframe struct {
+	Time  time.Duration
+	Value float64
+	// Ease is the easing of the segment from the previous keyframe to this one. If it is nil, the segment is linear.
+	Ease FloatInterpolater
+}
+
+// Keyframes is an animation that passes through each of Frames, which should be in order of Time. It holds the value
+// of the first frame before it starts, and the value of the last frame after it ends.
+//
+//	// Shake back and forth, and settle slowly.
+//	offset.Run(&animate.Keyframes{Frames: []animate.Keyframe{
+//		{Time: 0, Value: 0},
+//		{Time: 100 * time.Millisecond, Value: -10},
+//		{Time: 200 * time.Millisecond, Value: 10},
+//		{Time: 500 * time.Millisecond, Value: 0, Ease: animate.DefaultOutEase},
+//	}})
+type Keyframes struct {
+	Frames []Keyframe
+}
+
+// Duration implements the Animation interface.
+func (a *Keyframes) Duration() time.Duration {
+	if len(a.Frames) == 0 {
+		return 0
+	}
+	return a.Frames[len(a.Frames)-1].Time
+}
+
+// segment returns the frame that ends the segment running at t, the frame before it, and the ratio of the segment
+// that has passed. If t is outside of the frames, prev and next are the same.
+func (a *Keyframes) segment(t time.Duration) (prev, next Keyframe, ratio float64) {
+	if t <= a.Frames[0].Time {
+		return a.Frames[0], a.Frames[0], 0
+	}
+	for i := 1; i < len(a.Frames); i++ {
+		next := a.Frames[i]
+		if t < next.Time {
+			prev := a.Frames[i-1]
+			return prev, next, float64(t-prev.Time) / float64(next.Time-prev.Time)
+		}
+	}
+	last := a.Frames[len(a.Frames)-1]
+	return last, last, 1
+}
+
+func ease(e FloatInterpolater, ratio float64) float64 {
+	if e == nil {
+		return ratio
+	}
+	return e.Interpolate(ratio)
+}
+
+// Tick implements the Animation interface.
+func (a *Keyframes) Tick(t time.Duration) float64 {
+	if len(a.Frames) == 0 {
+		return 0
+	}
+	prev, next, ratio := a.segment(t)
+	return prev.Value + (next.Value-prev.Value)*ease(next.Ease, ratio)
+}
+
+// VelocityAt implements the VelocityAnimation interface.
+func (a *Keyframes) VelocityAt(t time.Duration) float64 {
+	if len(a.Frames) == 0 {
+		return 0
+	}
+	prev, next, ratio := a.segment(t)
+	if prev.Time == next.Time {
+		return 0
+	}
+	// Estimate the slope of the easing curve.
+	const h = 1e-4
+	r0, r1 := math.Max(0, ratio-h), math.Min(1, ratio+h)
+	slope := (ease(next.Ease, r1) - ease(next.Ease, r0)) / (r1 - r0)
+	return (next.Value - prev.Value) * slope / (next.Time - prev.Time).Seconds()
+}
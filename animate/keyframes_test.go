@@ -0,0 +1,42 @@
+package animate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestKeyframes(t *testing.T) {
+	a := &Keyframes{Frames: []Keyframe{
+		{Time: time.Second, Value: 10},
+		{Time: 2 * time.Second, Value: 20},
+		{Time: 4 * time.Second, Value: 0, Ease: PolyInEase{Exp: 2}},
+	}}
+	if a.Duration() != 4*time.Second {
+		t.Errorf("Incorrect duration: %v", a.Duration())
+	}
+	checkTicks(t, "Keyframes", a, []tickCase{
+		{0, 10},
+		{time.Second, 10},
+		{1500 * time.Millisecond, 15},
+		{2 * time.Second, 20},
+		{3 * time.Second, 15},
+		{4 * time.Second, 0},
+		{5 * time.Second, 0},
+	})
+
+	if v := a.VelocityAt(1500 * time.Millisecond); math.Abs(v-10) > 1e-6 {
+		t.Errorf("Incorrect linear velocity: %v", v)
+	}
+	// The derivative of -20*r^2 over 2 seconds at r = 0.5.
+	if v := a.VelocityAt(3 * time.Second); math.Abs(v+10) > 1e-3 {
+		t.Errorf("Incorrect eased velocity: %v", v)
+	}
+	if v := a.VelocityAt(5 * time.Second); v != 0 {
+		t.Errorf("Incorrect velocity after the end: %v", v)
+	}
+
+	if v := (&Keyframes{}).Tick(time.Second); v != 0 {
+		t.Errorf("Incorrect empty keyframes: %v", v)
+	}
+}
@@ -0,0 +1,166 @@
+package animate
+
+import (
+	"math"
+	"time"
+)
+
+// Spring is an animation that moves from Start to End like a mass attached to a damped spring. Its duration is the
+// time it takes to settle, after which it stays within Tolerance of End, with a speed of less than Tolerance per
+// second.
+//
+//	value.Run(&animate.Spring{
+//		Start:     value.Value(),
+//		End:       1,
+//		Stiffness: 300,
+//		Damping:   20,
+//	})
+//
+// To retarget a spring mid-flight, start a new spring from the current value and velocity, or use Value.Retarget.
+//
+//	value.Run(&animate.Spring{Start: value.Value(), End: 0, Velocity: value.Velocity()})
+type Spring struct {
+	Start float64
+	End   float64
+	// Velocity is the initial velocity in units per second.
+	Velocity float64
+	// Stiffness is the spring constant. If it is 0, DefaultSpringStiffness is used.
+	Stiffness float64
+	// Damping is the friction coefficient. A spring with damping less than 2*sqrt(Stiffness*Mass) oscillates around
+	// End. If it is 0, DefaultSpringDamping is used.
+	Damping float64
+	// Mass is the mass attached to the spring. If it is 0, 1 is used.
+	Mass float64
+	// Tolerance is the distance from End and the speed at which the spring is settled. If it is 0,
+	// DefaultSpringTolerance is used.
+	Tolerance float64
+
+	settled  time.Duration
+	settledP springParams
+}
+
+const (
+	DefaultSpringStiffness = 170
+	DefaultSpringDamping   = 26
+	DefaultSpringTolerance = 0.001
+)
+
+// maxSpringDuration limits the duration of springs with very little damping.
+const maxSpringDuration = time.Minute
+
+type springParams struct {
+	x0, v0, k, c, m, tolerance float64
+}
+
+func (a *Spring) params() springParams {
+	p := springParams{
+		x0:        a.Start - a.End,
+		v0:        a.Velocity,
+		k:         a.Stiffness,
+		c:         a.Damping,
+		m:         a.Mass,
+		tolerance: a.Tolerance,
+	}
+	if p.k <= 0 {
+		p.k = DefaultSpringStiffness
+	}
+	if p.c <= 0 {
+		p.c = DefaultSpringDamping
+	}
+	if p.m <= 0 {
+		p.m = 1
+	}
+	if p.tolerance <= 0 {
+		p.tolerance = DefaultSpringTolerance
+	}
+	return p
+}
+
+// at returns the displacement from the end and the velocity after t seconds, using the closed form solution of the
+// damped harmonic oscillator.
+func (p springParams) at(t float64) (x, v float64) {
+	w0 := math.Sqrt(p.k / p.m)
+	zeta := p.c / (2 * math.Sqrt(p.k*p.m))
+	switch {
+	case zeta < 1:
+		wd := w0 * math.Sqrt(1-zeta*zeta)
+		a, b := p.x0, (p.v0+zeta*w0*p.x0)/wd
+		e := math.Exp(-zeta * w0 * t)
+		cos, sin := math.Cos(wd*t), math.Sin(wd*t)
+		x = e * (a*cos + b*sin)
+		v = e * (-zeta*w0*(a*cos+b*sin) + wd*(b*cos-a*sin))
+	case zeta == 1:
+		a, b := p.x0, p.v0+w0*p.x0
+		e := math.Exp(-w0 * t)
+		x = e * (a + b*t)
+		v = e * (b - w0*(a+b*t))
+	default:
+		s := math.Sqrt(zeta*zeta - 1)
+		r1, r2 := -w0*(zeta-s), -w0*(zeta+s)
+		c1 := (p.v0 - r2*p.x0) / (r1 - r2)
+		c2 := p.x0 - c1
+		e1, e2 := math.Exp(r1*t), math.Exp(r2*t)
+		x = c1*e1 + c2*e2
+		v = c1*r1*e1 + c2*r2*e2
+	}
+	return x, v
+}
+
+// settledDuration returns the time after which the spring stays within tolerance, to the nearest millisecond.
+func (p springParams) settledDuration() time.Duration {
+	// The energy of a damped spring never increases, so once it is low enough that neither the displacement nor the
+	// velocity can exceed the tolerance, the spring is settled. Binary search for that time.
+	maxEnergy := 0.5 * math.Min(p.k, p.m) * p.tolerance * p.tolerance
+	energy := func(t time.Duration) float64 {
+		x, v := p.at(t.Seconds())
+		return 0.5*p.k*x*x + 0.5*p.m*v*v
+	}
+	lo, hi := time.Duration(0), maxSpringDuration
+	if energy(lo) <= maxEnergy {
+		hi = 0
+	}
+	for hi-lo > time.Millisecond {
+		mid := (lo + hi) / 2
+		if energy(mid) <= maxEnergy {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	// The spring may settle earlier, so search backwards for the last time that it is outside of the tolerance.
+	for t := hi.Truncate(time.Millisecond); t >= 0; t -= time.Millisecond {
+		x, v := p.at(t.Seconds())
+		if math.Abs(x) > p.tolerance || math.Abs(v) > p.tolerance {
+			return t + time.Millisecond
+		}
+	}
+	return 0
+}
+
+// Duration implements the Animation interface. It returns the time it takes for a to settle.
+func (a *Spring) Duration() time.Duration {
+	if p := a.params(); p != a.settledP || a.settled == 0 {
+		a.settled = p.settledDuration()
+		a.settledP = p
+	}
+	return a.settled
+}
+
+// Tick implements the Animation interface.
+func (a *Spring) Tick(t time.Duration) float64 {
+	if t >= a.Duration() {
+		return a.End
+	}
+	x, _ := a.params().at(t.Seconds())
+	return a.End + x
+}
+
+// VelocityAt implements the VelocityAnimation interface.
+func (a *Spring) VelocityAt(t time.Duration) float64 {
+	if t >= a.Duration() {
+		return 0
+	}
+	_, v := a.params().at(t.Seconds())
+	return v
+}
@@ -0,0 +1,95 @@
+package animate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSpringSettles(t *testing.T) {
+	for _, s := range []*Spring{
+		{Start: 0, End: 1},
+		{Start: 0, End: 1, Stiffness: 300, Damping: 5},   // underdamped
+		{Start: 0, End: 1, Stiffness: 100, Damping: 20},  // critically damped
+		{Start: 0, End: 1, Stiffness: 100, Damping: 100}, // overdamped
+		{Start: 10, End: -10, Velocity: 100, Mass: 2, Tolerance: 0.1},
+	} {
+		d := s.Duration()
+		if d <= 0 || d > 10*time.Second {
+			t.Errorf("%+v: unexpected duration %v", s, d)
+			continue
+		}
+		if v := s.Tick(0); v != s.Start {
+			t.Errorf("%+v: Tick(0) = %v", s, v)
+		}
+		if v := s.Tick(d); v != s.End {
+			t.Errorf("%+v: Tick(%v) = %v", s, d, v)
+		}
+		p := s.params()
+		for i := d; i < d+2*time.Second; i += time.Millisecond {
+			if x, v := p.at(i.Seconds()); math.Abs(x) > p.tolerance || math.Abs(v) > p.tolerance {
+				t.Errorf("%+v: unsettled at %v: %v %v", s, i, x, v)
+				break
+			}
+		}
+		if x, v := p.at((d - time.Millisecond).Seconds()); math.Abs(x) <= p.tolerance && math.Abs(v) <= p.tolerance {
+			t.Errorf("%+v: settled before %v", s, d)
+		}
+	}
+}
+
+func TestSpringDamping(t *testing.T) {
+	under := &Spring{End: 1, Stiffness: 300, Damping: 5}
+	over := &Spring{End: 1, Stiffness: 100, Damping: 100}
+	maxUnder, prevOver := 0.0, 0.0
+	for i := time.Duration(0); i < 2*time.Second; i += 10 * time.Millisecond {
+		maxUnder = math.Max(maxUnder, under.Tick(i))
+		v := over.Tick(i)
+		if v < prevOver || v > 1 {
+			t.Errorf("Overdamped spring moved backwards or overshot at %v: %v", i, v)
+		}
+		prevOver = v
+	}
+	if maxUnder <= 1.1 {
+		t.Errorf("Expected underdamped spring to overshoot: %v", maxUnder)
+	}
+}
+
+func TestSpringVelocity(t *testing.T) {
+	s := &Spring{Start: 0, End: 1, Velocity: 5, Stiffness: 200, Damping: 10}
+	if v := s.VelocityAt(0); math.Abs(v-5) > 1e-9 {
+		t.Errorf("Incorrect initial velocity: %v", v)
+	}
+	for _, i := range []time.Duration{50 * time.Millisecond, 200 * time.Millisecond} {
+		h := time.Microsecond
+		numeric := (s.Tick(i+h) - s.Tick(i-h)) / (2 * h.Seconds())
+		if v := s.VelocityAt(i); math.Abs(v-numeric) > 1e-3 {
+			t.Errorf("VelocityAt(%v) = %v, expected %v", i, v, numeric)
+		}
+	}
+	if v := s.VelocityAt(s.Duration()); v != 0 {
+		t.Errorf("Expected a settled spring to stop: %v", v)
+	}
+}
+
+func TestRetarget(t *testing.T) {
+	v := &Value{}
+	v.Run(&Spring{End: 1, Velocity: 5, Stiffness: 200})
+	h := v.Retarget(2)
+	defer h.Cancel()
+
+	s, ok := v.animation.animation.(*Spring)
+	if !ok {
+		t.Fatalf("Expected a spring: %v", v.animation.animation)
+	}
+	if s.End != 2 || s.Start != 0 || s.Stiffness != 200 {
+		t.Errorf("Incorrect spring: %+v", s)
+	}
+	// Only a moment has passed, so the velocity is preserved.
+	if math.Abs(s.Velocity-5) > 0.5 {
+		t.Errorf("Velocity was not preserved: %v", s.Velocity)
+	}
+	if math.Abs(v.Velocity()-5) > 0.5 {
+		t.Errorf("Incorrect velocity: %v", v.Velocity())
+	}
+}
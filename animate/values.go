@@ -0,0 +1,137 @@
+package animate
+
+import (
+	"image/color"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+)
+
+// PointValue is a layout.PointNotifier that animates between points. Its animations run from 0 at its current point to
+// 1 at the new point, so any Animation can be used, including Springs and Keyframes that overshoot.
+//
+//	v := &animate.PointValue{}
+//	v.SetValue(layout.Pt(0, 0))
+//	v.Run(layout.Pt(100, 50), &animate.Spring{End: 1})
+type PointValue struct {
+	from, to layout.Point
+	progress Value
+}
+
+// Notify implements the comm.Notifier interface.
+func (v *PointValue) Notify(f func()) comm.Id {
+	return v.progress.Notify(f)
+}
+
+// Unnotify implements the comm.Notifier interface.
+func (v *PointValue) Unnotify(id comm.Id) {
+	v.progress.Unnotify(id)
+}
+
+// Value returns the current point of v.
+func (v *PointValue) Value() layout.Point {
+	return v.from.Lerp(v.to, v.progress.Value())
+}
+
+// Velocity returns the velocity of v in points per second, if it is running a VelocityAnimation.
+func (v *PointValue) Velocity() layout.Point {
+	return v.to.Sub(v.from).Mul(v.progress.Velocity())
+}
+
+// SetValue updates v, calls any subscribed functions and cancels any running animations.
+func (v *PointValue) SetValue(p layout.Point) {
+	v.from, v.to = p, p
+	v.progress.reset()
+}
+
+// Run animates v from its current point to p, with a running from 0 to 1. It cancels any previously running animations
+// on v.
+func (v *PointValue) Run(p layout.Point, a Animation) *Handle {
+	v.from, v.to = v.Value(), p
+	v.progress.reset()
+	return v.progress.Run(a)
+}
+
+// RectValue is a layout.RectNotifier that animates between rects. Its animations run from 0 at its current rect to 1
+// at the new rect.
+type RectValue struct {
+	from, to layout.Rect
+	progress Value
+}
+
+// Notify implements the comm.Notifier interface.
+func (v *RectValue) Notify(f func()) comm.Id {
+	return v.progress.Notify(f)
+}
+
+// Unnotify implements the comm.Notifier interface.
+func (v *RectValue) Unnotify(id comm.Id) {
+	v.progress.Unnotify(id)
+}
+
+// Value returns the current rect of v.
+func (v *RectValue) Value() layout.Rect {
+	return v.from.Lerp(v.to, v.progress.Value())
+}
+
+// SetValue updates v, calls any subscribed functions and cancels any running animations.
+func (v *RectValue) SetValue(r layout.Rect) {
+	v.from, v.to = r, r
+	v.progress.reset()
+}
+
+// Run animates v from its current rect to r, with a running from 0 to 1. It cancels any previously running animations
+// on v.
+func (v *RectValue) Run(r layout.Rect, a Animation) *Handle {
+	v.from, v.to = v.Value(), r
+	v.progress.reset()
+	return v.progress.Run(a)
+}
+
+// ColorValue is a comm.ColorNotifier that animates between colors in Space. Its animations run from 0 at its current
+// color to 1 at the new color. A nil color is transparent.
+//
+//	v := &animate.ColorValue{Space: animate.ColorSpaceOKLab}
+//	v.SetValue(colornames.Red)
+//	v.Run(colornames.Blue, &animate.Basic{End: 1, Dur: time.Second})
+type ColorValue struct {
+	Space    ColorSpace
+	from, to color.Color
+	progress Value
+}
+
+// Notify implements the comm.Notifier interface.
+func (v *ColorValue) Notify(f func()) comm.Id {
+	return v.progress.Notify(f)
+}
+
+// Unnotify implements the comm.Notifier interface.
+func (v *ColorValue) Unnotify(id comm.Id) {
+	v.progress.Unnotify(id)
+}
+
+// Value returns the current color of v.
+func (v *ColorValue) Value() color.Color {
+	p := v.progress.Value()
+	if p == 0 && v.from != nil {
+		return v.from
+	}
+	if p == 1 && v.to != nil {
+		return v.to
+	}
+	return LerpColor(v.from, v.to, p, v.Space)
+}
+
+// SetValue updates v, calls any subscribed functions and cancels any running animations.
+func (v *ColorValue) SetValue(c color.Color) {
+	v.from, v.to = c, c
+	v.progress.reset()
+}
+
+// Run animates v from its current color to c, with a running from 0 to 1. It cancels any previously running animations
+// on v.
+func (v *ColorValue) Run(c color.Color, a Animation) *Handle {
+	v.from, v.to = v.Value(), c
+	v.progress.reset()
+	return v.progress.Run(a)
+}
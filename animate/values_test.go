@@ -0,0 +1,69 @@
+package animate
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"gomatcha.io/matcha/layout"
+)
+
+func TestValues(t *testing.T) {
+	clock := NewManualClock()
+	SetClock(clock)
+	defer SetClock(nil)
+
+	p := &PointValue{}
+	count := 0
+	id := p.Notify(func() { count += 1 })
+	defer p.Unnotify(id)
+
+	p.SetValue(layout.Pt(10, 10))
+	if p.Value() != layout.Pt(10, 10) || count != 1 {
+		t.Errorf("Incorrect value: %v %v", p.Value(), count)
+	}
+	p.Run(layout.Pt(20, 30), &Basic{End: 1, Dur: time.Second})
+	clock.Step(time.Second / 2)
+	if p.Value() != layout.Pt(15, 20) {
+		t.Errorf("Incorrect value: %v", p.Value())
+	}
+	if v := p.Velocity(); v != (layout.Point{}) {
+		t.Errorf("Basic animations don't have a velocity: %v", v)
+	}
+
+	// Running a new animation starts from the current value.
+	p.Run(layout.Pt(15, 0), &Basic{End: 1, Dur: time.Second})
+	clock.Step(time.Second / 2)
+	if p.Value() != layout.Pt(15, 10) {
+		t.Errorf("Incorrect value: %v", p.Value())
+	}
+	clock.Step(time.Second)
+	if p.Value() != layout.Pt(15, 0) {
+		t.Errorf("Incorrect value: %v", p.Value())
+	}
+
+	r := &RectValue{}
+	r.SetValue(layout.Rt(0, 0, 10, 10))
+	r.Run(layout.Rt(10, 10, 30, 30), &Spring{End: 1, Velocity: 2})
+	clock.Step(time.Hour)
+	if r.Value() != layout.Rt(10, 10, 30, 30) {
+		t.Errorf("Incorrect value: %v", r.Value())
+	}
+
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	blue := color.RGBA{0, 0, 0xff, 0xff}
+	c := &ColorValue{Space: ColorSpaceHSL}
+	c.SetValue(red)
+	if c.Value() != red {
+		t.Errorf("Incorrect value: %v", c.Value())
+	}
+	h := c.Run(blue, &Basic{End: 1, Dur: time.Second})
+	clock.Step(time.Second / 2)
+	if rgba(c.Value()) != (color.RGBA{0xff, 0, 0xff, 0xff}) {
+		t.Errorf("Incorrect value: %v", c.Value())
+	}
+	clock.Step(time.Second)
+	if c.Value() != blue || !h.Finished() {
+		t.Errorf("Incorrect value: %v", c.Value())
+	}
+}
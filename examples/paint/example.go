@@ -2,6 +2,8 @@
 package paint
 
 import (
+	"math"
+
 	"golang.org/x/image/colornames"
 	"gomatcha.io/bridge"
 	"gomatcha.io/matcha/layout"
@@ -48,7 +50,13 @@ func (v *PaintView) Build(ctx *view.Context) view.Model {
 	})
 
 	chl2 := basicview.New()
-	chl2.Painter = &paint.Style{BackgroundColor: colornames.Yellow}
+	chl2.Painter = &paint.Style{
+		BackgroundColor: colornames.Yellow,
+		CornerRadii:     paint.Radii(paint.CornerTop, 12),
+		Borders: &paint.Borders{
+			Bottom: paint.Border{Color: colornames.Black, Width: 2, Dash: []float64{6, 3}},
+		},
+	}
 	g2 := l.Add(chl2, func(s *constraint.Solver) {
 		s.TopEqual(g1.Bottom())
 		s.LeftEqual(g1.Left())
@@ -67,13 +75,36 @@ func (v *PaintView) Build(ctx *view.Context) view.Model {
 
 	chl4 := basicview.New()
 	chl4.Painter = &paint.Style{BackgroundColor: colornames.Magenta}
-	_ = l.Add(chl4, func(s *constraint.Solver) {
+	g4 := l.Add(chl4, func(s *constraint.Solver) {
 		s.TopEqual(g2.Bottom())
 		s.LeftEqual(g3.Left())
 		s.WidthEqual(constraint.Const(100))
 		s.HeightEqual(constraint.Const(100))
 	})
 
+	chl5 := basicview.New()
+	chl5.Painter = &paint.CanvasPainter{
+		Style: paint.Style{BackgroundColor: colornames.White},
+		Draw: func(c *paint.Canvas) {
+			b := c.Bounds().Inset(layout.In(10, 10, 10, 10))
+			p := &paint.Path{}
+			p.Arc(b.Center(), b.Width()/2, -math.Pi/2, math.Pi)
+			c.Stroke(p, colornames.Red, paint.StrokeStyle{Width: 6, Cap: paint.LineCapRound})
+
+			c.Save()
+			c.Translate(b.Center().X, b.Center().Y)
+			c.Rotate(math.Pi / 4)
+			c.FillRect(layout.Rt(-10, -10, 10, 10), colornames.Blue)
+			c.Restore()
+		},
+	}
+	_ = l.Add(chl5, func(s *constraint.Solver) {
+		s.TopEqual(g4.Top())
+		s.LeftEqual(g4.Right())
+		s.WidthEqual(constraint.Const(100))
+		s.HeightEqual(constraint.Const(100))
+	})
+
 	return view.Model{
 		Children: l.Views(),
 		Layouter: l,
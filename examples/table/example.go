@@ -21,17 +21,27 @@ func init() {
 
 type TableView struct {
 	view.Embed
+	scrollPosition *scrollview.ScrollPosition
 }
 
 func New() *TableView {
-	return &TableView{}
+	return &TableView{
+		scrollPosition: &scrollview.ScrollPosition{},
+	}
 }
 
 func (v *TableView) Build(ctx *view.Context) view.Model {
 	l := &constraint.Layouter{}
 
-	childLayouter := &table.Layouter{}
+	childLayouter := &table.Layouter{ScrollPosition: v.scrollPosition}
 	for i := 0; i < 20; i++ {
+		if i%5 == 0 {
+			header := NewTableCell()
+			header.String = "HEADER"
+			header.Painter = &paint.Style{BackgroundColor: colornames.Lightgray}
+			childLayouter.Add(header, &table.StickyHeader{})
+		}
+
 		childView := NewTableCell()
 		childView.String = "TEST TEST"
 		childView.Painter = &paint.Style{BackgroundColor: colornames.Red}
@@ -39,6 +49,7 @@ func (v *TableView) Build(ctx *view.Context) view.Model {
 	}
 
 	scrollView := scrollview.New()
+	scrollView.ScrollPosition = v.scrollPosition
 	scrollView.PaintStyle = &paint.Style{BackgroundColor: colornames.Cyan}
 	scrollView.ContentPainter = &paint.Style{BackgroundColor: colornames.White}
 	scrollView.ContentLayouter = childLayouter
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a source of time for animations and tickers.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+var clock = struct {
+	mu sync.Mutex
+	c  Clock
+}{
+	c: systemClock{},
+}
+
+// SetClock replaces the clock returned by Now. If c is nil, the system clock is restored.
+func SetClock(c Clock) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	if c == nil {
+		c = systemClock{}
+	}
+	clock.c = c
+}
+
+// Now returns the current time of the clock.
+func Now() time.Time {
+	clock.mu.Lock()
+	c := clock.c
+	clock.mu.Unlock()
+
+	return c.Now()
+}
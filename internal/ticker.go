@@ -19,10 +19,13 @@ var tickers = struct {
 }
 
 func init() {
-	bridge.RegisterFunc("gomatcha.io/matcha/animate screenUpdate", screenUpdate)
+	bridge.RegisterFunc("gomatcha.io/matcha/animate screenUpdate", ScreenUpdate)
 }
 
-func screenUpdate() {
+// ScreenUpdate signals all running tickers, and stops any that have expired. It is called by the display link on each
+// screen refresh.
+func ScreenUpdate() {
+	now := Now()
 	tickers.mu.Lock()
 	ts := []*Ticker{}
 	for _, i := range tickers.ts {
@@ -31,6 +34,10 @@ func screenUpdate() {
 	tickers.mu.Unlock()
 
 	for _, i := range ts {
+		if now.Sub(i.start) >= i.duration {
+			i.Stop()
+			continue
+		}
 		i.Signal()
 	}
 }
@@ -40,7 +47,6 @@ type Ticker struct {
 	mu       sync.Mutex
 	funcs    map[comm.Id]func()
 	maxId    comm.Id
-	timer    *time.Timer
 	start    time.Time
 	duration time.Duration
 }
@@ -53,12 +59,9 @@ func NewTicker(duration time.Duration) *Ticker {
 	t := &Ticker{
 		key:      tickers.maxKey,
 		funcs:    map[comm.Id]func(){},
-		start:    time.Now(),
+		start:    Now(),
 		duration: duration,
 	}
-	t.timer = time.AfterFunc(duration, func() {
-		t.Stop()
-	})
 	tickers.ts[t.key] = t
 	return t
 }
@@ -80,7 +83,7 @@ func (t *Ticker) Unnotify(key comm.Id) {
 }
 
 func (t *Ticker) Value() float64 {
-	v := float64(time.Since(t.start)) / float64(t.duration)
+	v := float64(Now().Sub(t.start)) / float64(t.duration)
 	if v < 0 {
 		v = 0
 	} else if v > 1 {
@@ -0,0 +1,411 @@
+package constraint
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"gomatcha.io/matcha/internal/device"
+	"gomatcha.io/matcha/layout"
+)
+
+const (
+	// rootPreference is the strength with which the layouter's guide matches the min guide. It is above Strong so that
+	// only Required constraints resize the layouter.
+	rootPreference = strongStrength * 10
+	// sizePreference is the strength with which unconstrained children match their preferred size.
+	sizePreference = weakStrength / 10
+	// centerPreference is the strength with which unconstrained children match the center of the parent.
+	centerPreference = weakStrength / 100
+)
+
+// UnsatisfiableError is reported when a Required constraint conflicts with other Required constraints. The constraint is
+// ignored during layout.
+type UnsatisfiableError struct {
+	// Constraint describes the ignored constraint.
+	Constraint string
+	// Conflicts describes a minimal set of constraints that Constraint conflicts with.
+	Conflicts []string
+}
+
+func (e *UnsatisfiableError) Error() string {
+	return fmt.Sprintf("constraint: unsatisfiable constraint %v, conflicts with [%v]", e.Constraint, strings.Join(e.Conflicts, ", "))
+}
+
+// guideVariables are the simplex variables for a guide. Right, bottom and center are derived from them.
+type guideVariables struct {
+	left, top, width, height *variable
+}
+
+func newGuideVariables(name string) *guideVariables {
+	return &guideVariables{
+		left:   &variable{name: name + ".Left"},
+		top:    &variable{name: name + ".Top"},
+		width:  &variable{name: name + ".Width"},
+		height: &variable{name: name + ".Height"},
+	}
+}
+
+func (v *guideVariables) expression(a attribute) linear {
+	switch a {
+	case leftAttr:
+		return linear{terms: []term{{v.left, 1}}}
+	case rightAttr:
+		return linear{terms: []term{{v.left, 1}, {v.width, 1}}}
+	case topAttr:
+		return linear{terms: []term{{v.top, 1}}}
+	case bottomAttr:
+		return linear{terms: []term{{v.top, 1}, {v.height, 1}}}
+	case widthAttr:
+		return linear{terms: []term{{v.width, 1}}}
+	case heightAttr:
+		return linear{terms: []term{{v.height, 1}}}
+	case centerXAttr:
+		return linear{terms: []term{{v.left, 1}, {v.width, 0.5}}}
+	case centerYAttr:
+		return linear{terms: []term{{v.top, 1}, {v.height, 0.5}}}
+	}
+	return linear{}
+}
+
+// cassowaryEntry is a constraint that has been added to the simplex, or that failed to be added.
+type cassowaryEntry struct {
+	constraint  *simplexConstraint
+	description string
+	seq         int
+	added       bool
+	err         error
+}
+
+type cassowaryKey struct {
+	solver *Solver
+	idx    int
+}
+
+// cassowary solves all of the constraints of a Layouter simultaneously. It is kept between layouts, so that only
+// constraints whose constants have changed are updated.
+type cassowary struct {
+	simplex  *simplex
+	vars     map[int]*guideVariables
+	entries  map[cassowaryKey]*cassowaryEntry
+	builtins []*cassowaryEntry
+	maxSeq   int
+}
+
+func newCassowary() *cassowary {
+	return &cassowary{
+		simplex: newSimplex(),
+		vars:    map[int]*guideVariables{},
+		entries: map[cassowaryKey]*cassowaryEntry{},
+	}
+}
+
+// guideName returns a description of the guide with the given index.
+func guideName(index int) string {
+	switch index {
+	case rootId:
+		return "Guide"
+	case minId:
+		return "MinGuide"
+	case maxId:
+		return "MaxGuide"
+	}
+	return fmt.Sprintf("Guide[%v]", index)
+}
+
+func (c *cassowary) variables(index int) *guideVariables {
+	v, ok := c.vars[index]
+	if !ok {
+		v = newGuideVariables(guideName(index))
+		c.vars[index] = v
+
+		// Guides cannot have a negative size.
+		c.addBuiltin(linear{terms: []term{{v.width, 1}}}, greater, requiredStrength, v.width.name+" >= 0")
+		c.addBuiltin(linear{terms: []term{{v.height, 1}}}, greater, requiredStrength, v.height.name+" >= 0")
+		preference := sizePreference
+		if index == rootId {
+			preference = rootPreference
+		}
+		_ = c.simplex.addEditVariable(v.width, preference)
+		_ = c.simplex.addEditVariable(v.height, preference)
+
+		if index == rootId {
+			// The layouter's guide is positioned at the origin.
+			c.addBuiltin(linear{terms: []term{{v.left, 1}}}, equal, requiredStrength, v.left.name+" = 0")
+			c.addBuiltin(linear{terms: []term{{v.top, 1}}}, equal, requiredStrength, v.top.name+" = 0")
+		} else {
+			// Unconstrained children are centered in the layouter's guide.
+			root := c.variables(rootId)
+			c.addBuiltin(v.expression(centerXAttr).sub(root.expression(centerXAttr)), equal, centerPreference, fmt.Sprintf("%v.CenterX = Guide.CenterX", guideName(index)))
+			c.addBuiltin(v.expression(centerYAttr).sub(root.expression(centerYAttr)), equal, centerPreference, fmt.Sprintf("%v.CenterY = Guide.CenterY", guideName(index)))
+		}
+	}
+	return v
+}
+
+func (c *cassowary) nextSeq() int {
+	c.maxSeq += 1
+	return c.maxSeq
+}
+
+func (c *cassowary) addBuiltin(e linear, comparison comparison, strength float64, description string) {
+	entry := &cassowaryEntry{
+		constraint:  &simplexConstraint{expression: e, comparison: comparison, strength: strength},
+		description: description,
+		seq:         c.nextSeq(),
+	}
+	entry.err = c.simplex.addConstraint(entry.constraint)
+	entry.added = entry.err == nil
+	c.builtins = append(c.builtins, entry)
+}
+
+// attribute returns the linear expression for the attribute a of the guide at index, in the layout direction of sys.
+func (c *cassowary) attribute(sys *Layouter, index int, a attribute) linear {
+	a, sign := sys.resolve(a)
+	return c.variables(index).expression(a).mul(sign)
+}
+
+// expression returns the linear expression for a. Anchors on the min and max guides, constants and notifiers are
+// evaluated immediately.
+func (c *cassowary) expression(sys *Layouter, a anchor) linear {
+	switch a := a.(type) {
+	case multiplierAnchor:
+		return c.expression(sys, a.underlying).mul(a.multiplier)
+	case offsetAnchor:
+		return c.expression(sys, a.underlying).add(linear{constant: a.offset})
+	case guideAnchor:
+		if a.guide.index == minId || a.guide.index == maxId {
+			return linear{constant: a.value(sys)}
+		}
+		return c.attribute(sys, a.guide.index, a.attribute)
+	}
+	return linear{constant: a.value(sys)}
+}
+
+func (c *cassowary) layout(sys *Layouter, ctx *layout.Context) []error {
+	// Only the most recent solver for each guide is used.
+	solvers := map[int]*Solver{}
+	order := []int{}
+	for _, i := range sys.solvers {
+		if _, ok := solvers[i.index]; !ok {
+			order = append(order, i.index)
+		}
+		solvers[i.index] = i
+	}
+	root := c.variables(rootId)
+	for idx := range sys.Guide.children2 {
+		c.variables(idx)
+	}
+
+	// Remove user constraints that have changed or that are no longer used.
+	pendings := []pendingConstraint{}
+	active := map[cassowaryKey]bool{}
+	changed := false
+	invalid := []error{}
+	for _, index := range order {
+		s := solvers[index]
+		for idx := range s.constraints {
+			i := &s.constraints[idx]
+			if err := i.directionError(s.index); err != nil {
+				invalid = append(invalid, err)
+				continue
+			}
+			key := cassowaryKey{solver: s, idx: idx}
+			active[key] = true
+
+			e := c.attribute(sys, s.index, i.attribute).sub(c.expression(sys, i.anchor))
+			pendings = append(pendings, pendingConstraint{key: key, constraint: i, expression: e})
+			entry, ok := c.entries[key]
+			if ok && entry.constraint.expression.constant == e.constant {
+				continue
+			}
+			changed = true
+			if ok {
+				if entry.added {
+					_ = c.simplex.removeConstraint(entry.constraint)
+				}
+				delete(c.entries, key)
+			}
+		}
+	}
+	for key, entry := range c.entries {
+		if !active[key] {
+			changed = true
+			if entry.added {
+				_ = c.simplex.removeConstraint(entry.constraint)
+			}
+			delete(c.entries, key)
+		}
+	}
+
+	// Constraints that failed may no longer conflict, and constraints that are added later may conflict with ones that
+	// were declared before them. So if anything changed while there are conflicts, rebuild the user constraints in order,
+	// giving the same result as a new layouter.
+	if changed && c.hasErrors() {
+		c.removeEntries()
+	}
+	errs, failed := c.addEntries(pendings)
+	if changed && failed {
+		c.removeEntries()
+		errs, _ = c.addEntries(pendings)
+	}
+	errs = append(invalid, errs...)
+
+	// The layouter prefers to match the min guide, and each child prefers its own size.
+	_ = c.simplex.suggestValue(root.width, ctx.MinSize.X)
+	_ = c.simplex.suggestValue(root.height, ctx.MinSize.Y)
+	for idx := range sys.Guide.children2 {
+		g := ctx.LayoutChild(idx, layout.Pt(0, 0), layout.Pt(math.Inf(1), math.Inf(1)))
+		v := c.variables(idx)
+		_ = c.simplex.suggestValue(v.width, g.Width())
+		_ = c.simplex.suggestValue(v.height, g.Height())
+	}
+	c.simplex.updateVariables()
+
+	// Materialize the guides.
+	sys.Guide.matchaGuide = &layout.Guide{
+		Frame: layout.Rt(0, 0, root.width.value, root.height.value),
+	}
+	// Record the constraints of each guide.
+	if sys.debugInfo != nil {
+		for _, index := range order {
+			s := solvers[index]
+			dg := sys.debugGuide(index)
+			for idx, i := range s.constraints {
+				entry := c.entries[cassowaryKey{solver: s, idx: idx}]
+				dc := DebugConstraint{Constraint: entry.description, Value: c.expression(sys, i.anchor).value(), Applied: entry.added}
+				if entry.err != nil {
+					dc.Reason = entry.err.Error()
+				}
+				dg.Constraints = append(dg.Constraints, dc)
+			}
+		}
+	}
+
+	for idx, i := range sys.Guide.children2 {
+		v := c.variables(idx)
+		width := math.Floor(v.width.value*device.ScreenScale+0.5) / device.ScreenScale
+		height := v.height.value
+		g := ctx.LayoutChild(idx, layout.Pt(width, height), layout.Pt(width, height))
+		g.Frame = layout.Rt(v.left.value, v.top.value, v.left.value+width, v.top.value+height)
+		g.ZIndex = idx
+		i.matchaGuide = &g
+
+		if s, ok := solvers[idx]; ok && s.debug {
+			fmt.Println("constraint: Debug", guideName(idx), g)
+		}
+	}
+	return errs
+}
+
+// pendingConstraint is a user constraint with its current expression.
+type pendingConstraint struct {
+	key        cassowaryKey
+	constraint *constraint
+	expression linear
+}
+
+func (c *cassowary) hasErrors() bool {
+	for _, i := range c.entries {
+		if i.err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// removeEntries removes all user constraints from the simplex.
+func (c *cassowary) removeEntries() {
+	for key, entry := range c.entries {
+		if entry.added {
+			_ = c.simplex.removeConstraint(entry.constraint)
+		}
+		delete(c.entries, key)
+	}
+}
+
+// addEntries adds the constraints in pendings that are not in the simplex, in order. It returns the errors of all
+// of the constraints, and whether any of the constraints that it added failed.
+func (c *cassowary) addEntries(pendings []pendingConstraint) ([]error, bool) {
+	errs := []error{}
+	failed := false
+	for _, p := range pendings {
+		s, i := p.key.solver, p.constraint
+		if entry, ok := c.entries[p.key]; ok {
+			if entry.err != nil {
+				errs = append(errs, entry.err)
+			}
+			continue
+		}
+
+		entry := &cassowaryEntry{
+			constraint: &simplexConstraint{
+				expression: p.expression,
+				comparison: i.comparison,
+				strength:   i.priority.strength(),
+			},
+			description: i.describe(s.index),
+			seq:         c.nextSeq(),
+		}
+		c.entries[p.key] = entry
+		if err := c.simplex.addConstraint(entry.constraint); err == errUnsatisfiable {
+			entry.err = &UnsatisfiableError{Constraint: entry.description, Conflicts: c.conflicts(entry)}
+		} else if err != nil {
+			entry.err = err
+		} else {
+			entry.added = true
+		}
+		if entry.err != nil {
+			errs = append(errs, entry.err)
+			failed = true
+		}
+		if s.debug {
+			fmt.Println("constraint: Debug", entry.description, entry.err)
+		}
+	}
+	return errs, failed
+}
+
+// conflicts returns a minimal set of Required constraints that conflict with entry.
+func (c *cassowary) conflicts(entry *cassowaryEntry) []string {
+	candidates := []*cassowaryEntry{}
+	for _, i := range c.builtins {
+		if i.added && i.constraint.strength >= requiredStrength {
+			candidates = append(candidates, i)
+		}
+	}
+	for _, i := range c.entries {
+		if i.added && i.constraint.strength >= requiredStrength {
+			candidates = append(candidates, i)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].seq < candidates[j].seq
+	})
+
+	satisfiable := func(entries []*cassowaryEntry) bool {
+		s := newSimplex()
+		for _, i := range entries {
+			_ = s.addConstraint(&simplexConstraint{expression: i.constraint.expression, comparison: i.constraint.comparison, strength: i.constraint.strength})
+		}
+		return s.addConstraint(&simplexConstraint{expression: entry.constraint.expression, comparison: entry.constraint.comparison, strength: entry.constraint.strength}) == nil
+	}
+
+	// Remove each candidate that is not needed to cause the conflict.
+	for idx := 0; idx < len(candidates); {
+		without := append(append([]*cassowaryEntry{}, candidates[:idx]...), candidates[idx+1:]...)
+		if !satisfiable(without) {
+			candidates = without
+		} else {
+			idx += 1
+		}
+	}
+
+	descriptions := []string{}
+	for _, i := range candidates {
+		descriptions = append(descriptions, i.description)
+	}
+	return descriptions
+}
@@ -0,0 +1,232 @@
+package constraint
+
+import (
+	"math"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+func layoutConstraints(l *Layouter, sizes []layout.Point, min layout.Point) (layout.Guide, []layout.Guide) {
+	ctx := &layout.Context{
+		MinSize:    min,
+		MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+		ChildCount: len(sizes),
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			s := sizes[idx]
+			s.X = math.Min(math.Max(s.X, min.X), max.X)
+			s.Y = math.Min(math.Max(s.Y, min.Y), max.Y)
+			return layout.Guide{Frame: layout.Rt(0, 0, s.X, s.Y)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+func TestCassowarySimultaneous(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	var first, second *Guide
+	first = l.Add(nil, func(s *Solver) {
+		s.Top(0)
+		s.Height(10)
+		s.LeftEqual(l.Left())
+	})
+	second = l.Add(nil, func(s *Solver) {
+		s.Top(0)
+		s.Height(10)
+		s.LeftEqual(first.Right())
+		s.RightEqual(l.Right())
+	})
+	// The first guide depends on the second, which is solved later.
+	first.Solve(func(s *Solver) {
+		s.Top(0)
+		s.Height(10)
+		s.LeftEqual(l.Left())
+		s.WidthEqual(second.Width())
+	})
+
+	g, gs := layoutConstraints(l, []layout.Point{{}, {}}, layout.Pt(100, 100))
+	if g.Frame != layout.Rt(0, 0, 100, 100) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(0, 0, 50, 10) || gs[1].Frame != layout.Rt(50, 0, 100, 10) {
+		t.Errorf("Incorrect child frames: %v", gs)
+	}
+	if len(l.Errors()) != 0 {
+		t.Errorf("Unexpected errors: %v", l.Errors())
+	}
+}
+
+func TestCassowaryDefaults(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	_ = l.Add(nil, nil)
+
+	// Unconstrained children keep their own size and are centered.
+	g, gs := layoutConstraints(l, []layout.Point{layout.Pt(20, 10)}, layout.Pt(100, 50))
+	if g.Frame != layout.Rt(0, 0, 100, 50) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(40, 20, 60, 30) {
+		t.Errorf("Incorrect child frame: %v", gs[0].Frame)
+	}
+}
+
+func TestCassowaryPriority(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	_ = l.Add(nil, func(s *Solver) {
+		s.Left(0)
+		s.Top(0)
+		s.Height(10)
+		s.WidthLess(l.Width())
+		s.SetPriority(Weak)
+		s.Width(200)
+	})
+	_ = l.Add(nil, func(s *Solver) {
+		s.Left(0)
+		s.Top(0)
+		s.Height(10)
+		s.SetPriority(Weak)
+		s.Width(200)
+		s.SetPriority(Strong)
+		s.Width(150)
+	})
+
+	_, gs := layoutConstraints(l, []layout.Point{{}, {}}, layout.Pt(100, 100))
+	if gs[0].Frame.Max.X != 100 {
+		t.Errorf("Weak constraint was not overridden: %v", gs[0].Frame)
+	}
+	if gs[1].Frame.Max.X != 150 {
+		t.Errorf("Strong constraint was not satisfied: %v", gs[1].Frame)
+	}
+
+	// Constraints on the min guide are updated when the size changes.
+	_, gs = layoutConstraints(l, []layout.Point{{}, {}}, layout.Pt(300, 100))
+	if gs[0].Frame.Max.X != 200 {
+		t.Errorf("Constraint was not updated: %v", gs[0].Frame)
+	}
+}
+
+func TestCassowaryUnsatisfiable(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	_ = l.Add(nil, func(s *Solver) {
+		s.Left(0)
+		s.Top(0)
+		s.Height(10)
+		s.Width(100)
+		s.RightEqual(l.Left().Add(50))
+		s.Width(200)
+	})
+
+	_, gs := layoutConstraints(l, []layout.Point{{}}, layout.Pt(300, 100))
+	if gs[0].Frame != layout.Rt(0, 0, 100, 10) {
+		t.Errorf("Incorrect child frame: %v", gs[0].Frame)
+	}
+	errs := l.Errors()
+	if len(errs) != 2 {
+		t.Fatalf("Incorrect errors: %v", errs)
+	}
+	err, ok := errs[1].(*UnsatisfiableError)
+	if !ok || err.Constraint != "Guide[0].Width = 200" {
+		t.Fatalf("Incorrect error: %v", errs[1])
+	}
+	if len(err.Conflicts) != 1 || err.Conflicts[0] != "Guide[0].Width = 100" {
+		t.Errorf("Incorrect conflicts: %v", err.Conflicts)
+	}
+	if err, ok := errs[0].(*UnsatisfiableError); !ok || err.Error() != "constraint: unsatisfiable constraint Guide[0].Right = Guide.Left+50, conflicts with [Guide.Left = 0, Guide[0].Left = 0, Guide[0].Width = 100]" {
+		t.Errorf("Incorrect error: %v", errs[0])
+	}
+}
+
+func TestSimplexEdit(t *testing.T) {
+	x, y := &variable{}, &variable{}
+	s := newSimplex()
+	// x + y = 10, x >= 2
+	_ = s.addConstraint(&simplexConstraint{expression: linear{terms: []term{{x, 1}, {y, 1}}, constant: -10}, comparison: equal, strength: requiredStrength})
+	_ = s.addConstraint(&simplexConstraint{expression: linear{terms: []term{{x, 1}}, constant: -2}, comparison: greater, strength: requiredStrength})
+	if err := s.addEditVariable(y, strongStrength); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []struct {
+		suggest, x, y float64
+	}{
+		{3, 7, 3},
+		{9, 2, 8},
+		{0, 10, 0},
+	} {
+		if err := s.suggestValue(y, i.suggest); err != nil {
+			t.Fatal(err)
+		}
+		s.updateVariables()
+		if x.value != i.x || y.value != i.y {
+			t.Errorf("Suggested %v: incorrect solution (%v, %v)", i.suggest, x.value, y.value)
+		}
+	}
+}
+
+func TestSimplexUnsatisfiableUnchanged(t *testing.T) {
+	x := &variable{}
+	s := newSimplex()
+	// x <= 100
+	if err := s.addConstraint(&simplexConstraint{expression: linear{terms: []term{{x, 1}}, constant: -100}, comparison: less, strength: requiredStrength}); err != nil {
+		t.Fatal(err)
+	}
+	s.updateVariables()
+	before := x.value
+
+	// x = 200
+	if err := s.addConstraint(&simplexConstraint{expression: linear{terms: []term{{x, 1}}, constant: -200}, comparison: equal, strength: requiredStrength}); err != errUnsatisfiable {
+		t.Fatalf("Expected errUnsatisfiable: %v", err)
+	}
+	s.updateVariables()
+	if x.value != before || x.value > 100 {
+		t.Errorf("Rejected constraint changed the solution: %v, expected %v", x.value, before)
+	}
+}
+
+func TestCassowaryUnsatisfiableUnchanged(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	_ = l.Add(nil, func(s *Solver) {
+		s.Left(0)
+		s.Top(0)
+		s.Height(10)
+		s.WidthLess(Const(100))
+		s.Width(200)
+	})
+
+	// The child prefers to be wider than the constraint allows.
+	_, gs := layoutConstraints(l, []layout.Point{layout.Pt(150, 0)}, layout.Pt(300, 100))
+	if gs[0].Frame != layout.Rt(0, 0, 100, 10) {
+		t.Errorf("Ignored constraint was applied: %v", gs[0].Frame)
+	}
+	if errs := l.Errors(); len(errs) != 1 {
+		t.Errorf("Incorrect errors: %v", errs)
+	}
+}
+
+func TestCassowaryRetry(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	_ = l.Add(nil, func(s *Solver) {
+		s.Left(0)
+		s.Top(0)
+		s.Height(10)
+		s.WidthLess(l.MinGuide().Width())
+		s.Width(200)
+	})
+
+	_, gs := layoutConstraints(l, []layout.Point{layout.Pt(300, 0)}, layout.Pt(100, 100))
+	if gs[0].Frame.Max.X != 100 || len(l.Errors()) != 1 {
+		t.Errorf("Expected a conflict: %v %v", gs[0].Frame, l.Errors())
+	}
+
+	// The conflicting constraint changes, so the failed constraint is retried.
+	_, gs = layoutConstraints(l, []layout.Point{layout.Pt(300, 0)}, layout.Pt(300, 100))
+	if gs[0].Frame.Max.X != 200 || len(l.Errors()) != 0 {
+		t.Errorf("Failed constraint was not retried: %v %v", gs[0].Frame, l.Errors())
+	}
+
+	// Constraints that are declared first still take precedence.
+	_, gs = layoutConstraints(l, []layout.Point{layout.Pt(300, 0)}, layout.Pt(150, 100))
+	if gs[0].Frame.Max.X != 150 || len(l.Errors()) != 1 {
+		t.Errorf("Expected a conflict: %v %v", gs[0].Frame, l.Errors())
+	}
+}
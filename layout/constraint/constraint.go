@@ -51,12 +51,52 @@ Package constraint implements a constraint-based layout system.
 
 If a child view is unconstrained in x or y, it will try to move as close to the center of the parent as possible.
 If the view is unconstrained in width or height, it will try to match the minGuide as close as possible.
+
+Setting the Layouter's Strategy to Cassowary solves the constraints of all guides simultaneously, so guides may depend
+on guides that are added after them. Constraints can be given a lower priority, in which case they are only satisfied
+if they do not conflict with higher priority constraints.
+
+	l := &constraint.Layouter{Strategy: constraint.Cassowary}
+	label := l.Add(labelView, func(s *constraint.Solver) {
+		s.LeftEqual(l.Left().Add(10))
+		s.CenterYEqual(l.CenterY())
+	})
+	_ = l.Add(buttonView, func(s *constraint.Solver) {
+		s.LeftGreater(label.Right().Add(10))
+		s.RightEqual(l.Right().Add(-10))
+		s.CenterYEqual(l.CenterY())
+		s.SetPriority(constraint.Weak)
+		s.Width(200) // Shrinks if there is not enough room.
+	})
+
+Required constraints that conflict with each other are ignored, and reported by Errors() along with the constraints they conflict with.
+
+Calling Debug() records which constraints were applied to each guide, which were ignored and why. After layout, the
+record is available from DebugInfo(), and can be printed or encoded as JSON. DebugOverlay() additionally adds views to
+Views() that outline each guide.
+
+	l := &constraint.Layouter{}
+	l.DebugOverlay()
+	...
+	// During a later layout or build.
+	fmt.Println(l.DebugInfo())
+
+Views can also be laid out with visual format strings, which describe rows and columns of views and the spacing
+between them. AddFormat() returns a *FormatError describing where a string failed to parse.
+
+	l := &constraint.Layouter{Strategy: constraint.Cassowary}
+	_, err := l.AddFormat(map[string]view.View{"label": labelView, "button": buttonView}, nil,
+		"H:|-[label]-[button(>=80)]-|",
+		"V:|-[label]",
+		"V:|-[button]",
+	)
 */
 package constraint
 
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"gomatcha.io/matcha/comm"
 	"gomatcha.io/matcha/internal/device"
@@ -84,6 +124,59 @@ func (c comparison) String() string {
 	return ""
 }
 
+// Priority is the strength of a constraint. When constraints conflict, the constraint with the higher priority is
+// satisfied.
+type Priority int
+
+const (
+	// Required constraints must be satisfied.
+	Required Priority = iota
+	// Strong constraints are satisfied before Weak ones.
+	Strong
+	// Weak constraints are satisfied if possible.
+	Weak
+)
+
+const (
+	requiredStrength float64 = 1001001000
+	strongStrength   float64 = 1000000
+	weakStrength     float64 = 1
+)
+
+func (p Priority) strength() float64 {
+	switch p {
+	case Strong:
+		return strongStrength
+	case Weak:
+		return weakStrength
+	}
+	return requiredStrength
+}
+
+func (p Priority) String() string {
+	switch p {
+	case Required:
+		return "Required"
+	case Strong:
+		return "Strong"
+	case Weak:
+		return "Weak"
+	}
+	return ""
+}
+
+// Strategy determines how a Layouter solves its constraints.
+type Strategy int
+
+const (
+	// Greedy solves each guide in the order it was added. Constraints on a guide are applied from highest to lowest
+	// priority, and a constraint that conflicts with the previously applied constraints is ignored.
+	Greedy Strategy = iota
+	// Cassowary solves the constraints of all guides simultaneously, satisfying higher priority constraints first.
+	// Required constraints that conflict with each other are ignored and reported by Layouter.Errors().
+	Cassowary
+)
+
 type attribute int
 
 const (
@@ -95,6 +188,8 @@ const (
 	heightAttr
 	centerXAttr
 	centerYAttr
+	leadingAttr
+	trailingAttr
 )
 
 func (a attribute) String() string {
@@ -115,6 +210,10 @@ func (a attribute) String() string {
 		return "CenterX"
 	case centerYAttr:
 		return "CenterY"
+	case leadingAttr:
+		return "Leading"
+	case trailingAttr:
+		return "Trailing"
 	}
 	return ""
 }
@@ -157,6 +256,10 @@ func (a multiplierAnchor) value(sys *Layouter) float64 {
 	return a.underlying.value(sys) * a.multiplier
 }
 
+func (a multiplierAnchor) String() string {
+	return fmt.Sprintf("%v*%v", a.underlying, a.multiplier)
+}
+
 type offsetAnchor struct {
 	offset     float64
 	underlying anchor
@@ -166,6 +269,13 @@ func (a offsetAnchor) value(sys *Layouter) float64 {
 	return a.underlying.value(sys) + a.offset
 }
 
+func (a offsetAnchor) String() string {
+	if a.offset < 0 {
+		return fmt.Sprintf("%v-%v", a.underlying, -a.offset)
+	}
+	return fmt.Sprintf("%v+%v", a.underlying, a.offset)
+}
+
 type constAnchor float64
 
 func (a constAnchor) value(sys *Layouter) float64 {
@@ -180,33 +290,45 @@ func (a notifierAnchor) value(sys *Layouter) float64 {
 	return a.n.Value()
 }
 
+func (a notifierAnchor) String() string {
+	return fmt.Sprintf("Notifier(%v)", a.n.Value())
+}
+
 type guideAnchor struct {
 	guide     *Guide
 	attribute attribute
 }
 
+func (a guideAnchor) String() string {
+	return fmt.Sprintf("%v.%v", guideName(a.guide.index), a.attribute)
+}
+
+// value returns the attribute of the guide, or NaN if the guide has not been solved yet.
 func (a guideAnchor) value(sys *Layouter) float64 {
-	var g layout.Guide
+	var mg *layout.Guide
 	switch a.guide.index {
 	case rootId:
-		g = *sys.Guide.matchaGuide
+		mg = sys.Guide.matchaGuide
 	case minId:
-		g = *sys.min.matchaGuide
+		mg = sys.min.matchaGuide
 	case maxId:
-		g = *sys.max.matchaGuide
+		mg = sys.max.matchaGuide
 	default:
-		g = *sys.children2[a.guide.index].matchaGuide
+		if a.guide.index < len(sys.children2) {
+			mg = sys.children2[a.guide.index].matchaGuide
+		}
 	}
+	if mg == nil {
+		return math.NaN()
+	}
+	g := *mg
 
-	// if g == nil {
-	// 	return 0
-	// }
-
-	switch a.attribute {
+	attr, sign := sys.resolve(a.attribute)
+	switch attr {
 	case leftAttr:
-		return g.Left()
+		return sign * g.Left()
 	case rightAttr:
-		return g.Right()
+		return sign * g.Right()
 	case topAttr:
 		return g.Top()
 	case bottomAttr:
@@ -261,6 +383,20 @@ func (g *Guide) Left() *Anchor {
 	return &Anchor{guideAnchor{guide: g, attribute: leftAttr}}
 }
 
+// Leading returns the edge where text starts as an Anchor. In a left-to-right layout direction it is the left edge.
+// In a right-to-left layout direction it is the negated right edge, so that g.Leading().Add(8) is 8 points inside of
+// g in either direction. Leading and trailing anchors can only be constrained to other leading and trailing anchors.
+// Constraints that mix them with Left, Right or CenterX are ignored and reported by Layouter.Errors().
+func (g *Guide) Leading() *Anchor {
+	return &Anchor{guideAnchor{guide: g, attribute: leadingAttr}}
+}
+
+// Trailing returns the edge where text ends as an Anchor. In a left-to-right layout direction it is the right edge.
+// In a right-to-left layout direction it is the negated left edge. See Leading.
+func (g *Guide) Trailing() *Anchor {
+	return &Anchor{guideAnchor{guide: g, attribute: trailingAttr}}
+}
+
 // Width returns the width of g as an Anchor.
 func (g *Guide) Width() *Anchor {
 	return &Anchor{guideAnchor{guide: g, attribute: widthAttr}}
@@ -324,38 +460,123 @@ type constraint struct {
 	attribute  attribute
 	comparison comparison
 	anchor     anchor
+	priority   Priority
 }
 
 func (c constraint) String() string {
 	return fmt.Sprintf("%v%v%v", c.attribute, c.comparison, c.anchor)
 }
 
+// describe returns a description of c, as applied to the guide with the given index.
+func (c constraint) describe(index int) string {
+	str := fmt.Sprintf("%v.%v %v %v", guideName(index), c.attribute, c.comparison, c.anchor)
+	if c.priority != Required {
+		str += fmt.Sprintf(" (%v)", c.priority)
+	}
+	return str
+}
+
+// mixesDirections returns whether c constrains a leading or trailing attribute to a left, right or center x anchor, or
+// the reverse. Leading and trailing values are negated in a right-to-left layout direction, so they cannot be mixed.
+func (c constraint) mixesDirections() bool {
+	a, ok := anchorAttribute(c.anchor)
+	if !ok {
+		return false
+	}
+	return (isDirectional(c.attribute) && isHorizontal(a)) || (isHorizontal(c.attribute) && isDirectional(a))
+}
+
+// directionError returns an error if c mixes directional and horizontal attributes.
+func (c constraint) directionError(index int) error {
+	if !c.mixesDirections() {
+		return nil
+	}
+	return fmt.Errorf("constraint: %v mixes leading or trailing with left, right or center x", c.describe(index))
+}
+
+// anchorAttribute returns the guide attribute that a is derived from.
+func anchorAttribute(a anchor) (attribute, bool) {
+	switch a := a.(type) {
+	case guideAnchor:
+		return a.attribute, true
+	case offsetAnchor:
+		return anchorAttribute(a.underlying)
+	case multiplierAnchor:
+		return anchorAttribute(a.underlying)
+	}
+	return 0, false
+}
+
+func isDirectional(a attribute) bool {
+	return a == leadingAttr || a == trailingAttr
+}
+
+func isHorizontal(a attribute) bool {
+	return a == leftAttr || a == rightAttr || a == centerXAttr
+}
+
 // Solver is a list of constraints to be applied to a view.
 type Solver struct {
 	debug       bool
 	index       int
+	priority    Priority
 	constraints []constraint
 }
 
 func (s *Solver) solve(sys *Layouter, ctx *layout.Context) {
 	cr := newConstrainedRect()
 
-	for _, i := range s.constraints {
+	// Apply higher priority constraints first.
+	constraints := make([]constraint, len(s.constraints))
+	copy(constraints, s.constraints)
+	sort.SliceStable(constraints, func(i, j int) bool {
+		return constraints[i].priority < constraints[j].priority
+	})
+
+	dg := sys.debugGuide(s.index)
+	if dg != nil {
+		dg.Constraints = []DebugConstraint{}
+	}
+
+	for _, i := range constraints {
 		copy := cr
 
+		if err := i.directionError(s.index); err != nil {
+			sys.errors = append(sys.errors, err)
+			if dg != nil {
+				dg.Constraints = append(dg.Constraints, DebugConstraint{Constraint: i.describe(s.index), Value: i.anchor.value(sys), Reason: "mixes leading or trailing with left, right or center x"})
+			}
+			continue
+		}
+
+		// Constraints against guides that are solved later cannot be applied.
+		v := i.anchor.value(sys)
+		if math.IsNaN(v) {
+			sys.errors = append(sys.errors, fmt.Errorf("constraint: %v depends on a guide that is solved after it", i.describe(s.index)))
+			if dg != nil {
+				dg.Constraints = append(dg.Constraints, DebugConstraint{Constraint: i.describe(s.index), Value: v, Reason: "depends on a guide that is solved after it"})
+			}
+			continue
+		}
+
 		// Generate the range from constraint
 		var r _range
 		switch i.comparison {
 		case equal:
-			r = _range{min: i.anchor.value(sys), max: i.anchor.value(sys)}
+			r = _range{min: v, max: v}
 		case greater:
-			r = _range{min: i.anchor.value(sys), max: math.Inf(1)}
+			r = _range{min: v, max: math.Inf(1)}
 		case less:
-			r = _range{min: math.Inf(-1), max: i.anchor.value(sys)}
+			r = _range{min: math.Inf(-1), max: v}
+		}
+
+		attr, sign := sys.resolve(i.attribute)
+		if sign < 0 {
+			r = _range{min: -r.max, max: -r.min}
 		}
 
 		// Update the solver
-		switch i.attribute {
+		switch attr {
 		case leftAttr:
 			copy.left = copy.left.intersect(r)
 		case rightAttr:
@@ -377,15 +598,24 @@ func (s *Solver) solve(sys *Layouter, ctx *layout.Context) {
 		// Validate that the new system is well-formed. Otherwise ignore the changes.
 		if !copy.isValid() {
 			if s.debug {
-				fmt.Println("constraint: Debug 0", i, copy) // TODO(KD): Better debugging.
+				fmt.Println("constraint: Debug 0", i, copy)
+			}
+			if dg != nil {
+				dg.Constraints = append(dg.Constraints, DebugConstraint{Constraint: i.describe(s.index), Value: v, Reason: copy.invalidReason()})
 			}
 			continue
 		}
 		cr = copy
+		if dg != nil {
+			dg.Constraints = append(dg.Constraints, DebugConstraint{Constraint: i.describe(s.index), Value: v, Applied: true})
+		}
 	}
 	if s.debug {
 		fmt.Println("constraint: Debug 1", cr, s.constraints)
 	}
+	if dg != nil {
+		dg.Ranges = cr.debugRanges()
+	}
 
 	// Get parent guide.
 	var parent layout.Guide
@@ -447,6 +677,9 @@ func (s *Solver) solve(sys *Layouter, ctx *layout.Context) {
 	} else {
 		sys.Guide.children2[s.index].matchaGuide = &g
 	}
+	if dg != nil {
+		dg.Frame = g.Frame
+	}
 	if s.debug {
 		fmt.Println("constraint: Debug 2", g)
 	}
@@ -457,20 +690,25 @@ func (s *Solver) Debug() {
 	s.debug = true
 }
 
+// SetPriority sets the priority of the constraints that are subsequently added to s. Constraints are Required by default.
+func (s *Solver) SetPriority(p Priority) {
+	s.priority = p
+}
+
 func (s *Solver) Top(v float64) {
 	s.TopEqual(Const(v))
 }
 
 func (s *Solver) TopEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) TopLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) TopGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: topAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) Right(v float64) {
@@ -478,15 +716,15 @@ func (s *Solver) Right(v float64) {
 }
 
 func (s *Solver) RightEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) RightLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) RightGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: rightAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) Bottom(v float64) {
@@ -494,15 +732,15 @@ func (s *Solver) Bottom(v float64) {
 }
 
 func (s *Solver) BottomEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) BottomLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) BottomGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: bottomAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) Left(v float64) {
@@ -510,15 +748,39 @@ func (s *Solver) Left(v float64) {
 }
 
 func (s *Solver) LeftEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) LeftLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) LeftGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: leftAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) LeadingEqual(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: leadingAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) LeadingLess(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: leadingAttr, comparison: less, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) LeadingGreater(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: leadingAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) TrailingEqual(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: trailingAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) TrailingLess(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: trailingAttr, comparison: less, anchor: a.anchor, priority: s.priority})
+}
+
+func (s *Solver) TrailingGreater(a *Anchor) {
+	s.constraints = append(s.constraints, constraint{attribute: trailingAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) Width(v float64) {
@@ -526,15 +788,15 @@ func (s *Solver) Width(v float64) {
 }
 
 func (s *Solver) WidthEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) WidthLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) WidthGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: widthAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) Height(v float64) {
@@ -542,15 +804,15 @@ func (s *Solver) Height(v float64) {
 }
 
 func (s *Solver) HeightEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) HeightLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) HeightGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: heightAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterX(v float64) {
@@ -558,15 +820,15 @@ func (s *Solver) CenterX(v float64) {
 }
 
 func (s *Solver) CenterXEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterXLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterXGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerXAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterY(v float64) {
@@ -574,15 +836,15 @@ func (s *Solver) CenterY(v float64) {
 }
 
 func (s *Solver) CenterYEqual(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: equal, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: equal, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterYLess(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: less, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: less, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) CenterYGreater(a *Anchor) {
-	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: greater, anchor: a.anchor})
+	s.constraints = append(s.constraints, constraint{attribute: centerYAttr, comparison: greater, anchor: a.anchor, priority: s.priority})
 }
 
 func (s *Solver) String() string {
@@ -600,6 +862,14 @@ const (
 type Layouter struct {
 	// Guide represents the size of the view that the layouter is attached to. By default, Guide is the same size as MinGuide.
 	Guide
+	// Strategy determines how the constraints are solved. It defaults to Greedy.
+	Strategy       Strategy
+	direction      layout.Direction
+	cassowary      *cassowary
+	errors         []error
+	debug          bool
+	debugOverlay   bool
+	debugInfo      *DebugInfo
 	min            Guide
 	max            Guide
 	solvers        []*Solver
@@ -621,9 +891,33 @@ func (l *Layouter) initialize() {
 
 // View returns a list of all views added to l.
 func (l *Layouter) Views() []view.View {
+	if l.debugOverlay {
+		vs := make([]view.View, 0, len(l.views))
+		vs = append(vs, l.views...)
+		return append(vs, l.overlayViews()...)
+	}
 	return l.views
 }
 
+// resolve returns the attribute that a refers to in the layout direction of l, and the sign of its value. In a
+// right-to-left layout direction, leading and trailing values are negated right and left coordinates, so that offsets
+// and comparisons between them are mirrored along with the edges.
+func (l *Layouter) resolve(a attribute) (attribute, float64) {
+	switch a {
+	case leadingAttr:
+		if l.direction == layout.RightToLeft {
+			return rightAttr, -1
+		}
+		return leftAttr, 1
+	case trailingAttr:
+		if l.direction == layout.RightToLeft {
+			return leftAttr, -1
+		}
+		return rightAttr, 1
+	}
+	return a, 1
+}
+
 // MinGuide returns a guide representing the smallest allowed size for the view.
 func (l *Layouter) MinGuide() *Guide {
 	l.initialize()
@@ -650,8 +944,32 @@ func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
 	}
 	// TODO(KD): reset all guides
 
-	for _, i := range l.solvers {
-		i.solve(l, ctx)
+	if l.direction != ctx.Direction {
+		// Leading and trailing constraints resolve to different edges, so the cached solver must be rebuilt.
+		l.direction = ctx.Direction
+		l.cassowary = nil
+	}
+
+	l.debugInfo = nil
+	if l.debug {
+		l.debugInfo = &DebugInfo{Strategy: l.Strategy}
+	}
+
+	switch l.Strategy {
+	case Cassowary:
+		if l.cassowary == nil {
+			l.cassowary = newCassowary()
+		}
+		l.errors = l.cassowary.layout(l, ctx)
+	default:
+		// Forget the previous layout, so that guides solved later are not read with stale frames.
+		l.errors = nil
+		for _, i := range l.children2 {
+			i.matchaGuide = nil
+		}
+		for _, i := range l.solvers {
+			i.solve(l, ctx)
+		}
 	}
 
 	g := *l.Guide.matchaGuide
@@ -659,9 +977,22 @@ func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
 	for _, i := range l.Guide.children2 {
 		gs = append(gs, *i.matchaGuide)
 	}
+	if l.debugInfo != nil {
+		l.debugFrames()
+	}
+	if l.debugOverlay {
+		gs = l.overlayGuides(ctx, g, gs)
+	}
 	return g, gs
 }
 
+// Errors returns the constraints that could not be satisfied during the most recent layout, and constraints that mix
+// leading or trailing with left, right or center x. The Greedy strategy does not report conflicting constraints, only
+// constraints that depend on guides that are solved after them.
+func (l *Layouter) Errors() []error {
+	return l.errors
+}
+
 // Add immediately calls solveFunc to generate the constraints for v. These constraints are solved by l during the layout phase.
 // A corresponding guide is returned, which can be used to position other views or reposition v. If the view is not fully constrained
 // it will try to match the MinGuide in dimension and center. If the child view is not fully constrained it will try to match the parent in center.
@@ -727,6 +1058,10 @@ func (r _range) intersect(r2 _range) _range {
 	return _range{min: math.Max(r.min, r2.min), max: math.Min(r.max, r2.max)}
 }
 
+func (r _range) String() string {
+	return fmt.Sprintf("[%v, %v]", r.min, r.max)
+}
+
 func (r _range) isValid() bool {
 	if r.max < r.min {
 		fmt.Println("invalid2", r.max-r.min)
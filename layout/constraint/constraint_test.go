@@ -3,6 +3,8 @@ package constraint
 import (
 	"math"
 	"testing"
+
+	"gomatcha.io/matcha/layout"
 )
 
 func TestConstrainedRect(t *testing.T) {
@@ -187,3 +189,202 @@ func TestSolveWidth(t *testing.T) {
 		t.Errorf("Incorrect solution: (%v, %v)", w, ok)
 	}
 }
+
+func TestLeadingTrailing(t *testing.T) {
+	for _, strategy := range []Strategy{Greedy, Cassowary} {
+		l := &Layouter{Strategy: strategy}
+		a := l.Add(nil, func(s *Solver) {
+			s.Top(0)
+			s.Height(10)
+			s.Width(20)
+			s.LeadingEqual(l.Leading().Add(10))
+		})
+		b := l.Add(nil, func(s *Solver) {
+			s.Top(0)
+			s.Height(10)
+			s.LeadingEqual(a.Trailing().Add(5))
+			s.TrailingEqual(l.Trailing().Add(-10))
+		})
+
+		for _, i := range []struct {
+			direction layout.Direction
+			a, b      layout.Rect
+		}{
+			{layout.LeftToRight, layout.Rt(10, 0, 30, 10), layout.Rt(35, 0, 90, 10)},
+			{layout.RightToLeft, layout.Rt(70, 0, 90, 10), layout.Rt(10, 0, 65, 10)},
+		} {
+			ctx := &layout.Context{
+				MinSize:    layout.Pt(100, 100),
+				MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+				ChildCount: 2,
+				Direction:  i.direction,
+				LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+					return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+				},
+			}
+			_, gs := l.Layout(ctx)
+			if gs[a.index].Frame != i.a || gs[b.index].Frame != i.b {
+				t.Errorf("%v %v: Incorrect frames: %v %v", strategy, i.direction, gs[a.index].Frame, gs[b.index].Frame)
+			}
+		}
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	for _, i := range []struct {
+		name  string
+		setup func(l *Layouter) []*Guide
+		want  []layout.Rect
+	}{
+		{
+			name: "fixed",
+			setup: func(l *Layouter) []*Guide {
+				return []*Guide{l.Add(nil, func(s *Solver) {
+					s.Top(5)
+					s.Left(10)
+					s.Width(20)
+					s.Height(30)
+				})}
+			},
+			want: []layout.Rect{layout.Rt(10, 5, 30, 35)},
+		},
+		{
+			name: "edges",
+			setup: func(l *Layouter) []*Guide {
+				return []*Guide{l.Add(nil, func(s *Solver) {
+					s.TopEqual(l.Top().Add(10))
+					s.BottomEqual(l.Bottom().Add(-10))
+					s.LeftEqual(l.Left().Add(20))
+					s.RightEqual(l.Right().Add(-20))
+				})}
+			},
+			want: []layout.Rect{layout.Rt(20, 10, 80, 90)},
+		},
+		{
+			name: "center",
+			setup: func(l *Layouter) []*Guide {
+				return []*Guide{l.Add(nil, func(s *Solver) {
+					s.Width(20)
+					s.Height(40)
+					s.CenterXEqual(l.CenterX())
+					s.CenterYEqual(l.CenterY())
+				})}
+			},
+			want: []layout.Rect{layout.Rt(40, 30, 60, 70)},
+		},
+		{
+			name: "sibling",
+			setup: func(l *Layouter) []*Guide {
+				a := l.Add(nil, func(s *Solver) {
+					s.Top(0)
+					s.Left(0)
+					s.Width(30)
+					s.Height(10)
+				})
+				b := l.Add(nil, func(s *Solver) {
+					s.TopEqual(a.Bottom().Add(5))
+					s.LeftEqual(a.Right())
+					s.WidthEqual(a.Width().Mul(2))
+					s.HeightEqual(a.Height())
+				})
+				return []*Guide{a, b}
+			},
+			want: []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(30, 15, 90, 25)},
+		},
+	} {
+		for _, strategy := range []Strategy{Greedy, Cassowary} {
+			l := &Layouter{Strategy: strategy}
+			guides := i.setup(l)
+			ctx := &layout.Context{
+				MinSize:    layout.Pt(100, 100),
+				MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+				ChildCount: len(guides),
+				LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+					return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+				},
+			}
+			_, gs := l.Layout(ctx)
+			for idx, g := range guides {
+				if gs[g.index].Frame != i.want[idx] {
+					t.Errorf("%v %v: Incorrect frame %v: %v", i.name, strategy, idx, gs[g.index].Frame)
+				}
+			}
+			if errs := l.Errors(); len(errs) != 0 {
+				t.Errorf("%v %v: Unexpected errors: %v", i.name, strategy, errs)
+			}
+		}
+	}
+}
+
+func TestGreedyUnsolvedGuide(t *testing.T) {
+	l := &Layouter{}
+	b := &Guide{index: 1, system: l}
+	a := l.Add(nil, func(s *Solver) {
+		s.Top(0)
+		s.Left(0)
+		s.Height(10)
+		s.Width(20)
+		s.WidthEqual(b.Width()) // b is solved after a.
+	})
+	l.Add(nil, func(s *Solver) {
+		s.Top(0)
+		s.Left(30)
+		s.Width(50)
+		s.Height(10)
+	})
+
+	for i := 0; i < 2; i++ {
+		ctx := &layout.Context{
+			MinSize:    layout.Pt(100, 100),
+			MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+			ChildCount: 2,
+			LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+				return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+			},
+		}
+		_, gs := l.Layout(ctx)
+		if gs[a.index].Frame != layout.Rt(0, 0, 20, 10) {
+			t.Errorf("%v: Incorrect frame: %v", i, gs[a.index].Frame)
+		}
+		if len(l.Errors()) != 1 {
+			t.Errorf("%v: Expected an error: %v", i, l.Errors())
+		}
+	}
+}
+
+func TestMixedDirections(t *testing.T) {
+	for _, strategy := range []Strategy{Greedy, Cassowary} {
+		l := &Layouter{Strategy: strategy}
+		a := l.Add(nil, func(s *Solver) {
+			s.Top(0)
+			s.Height(10)
+			s.Width(20)
+			s.LeadingEqual(l.Leading().Add(10))
+		})
+		b := l.Add(nil, func(s *Solver) {
+			s.Top(0)
+			s.Height(10)
+			s.Width(20)
+			s.LeadingEqual(a.Trailing().Add(5))
+			s.TrailingEqual(a.Left())   // Mixed.
+			s.CenterXEqual(a.Leading()) // Mixed.
+		})
+
+		ctx := &layout.Context{
+			MinSize:    layout.Pt(100, 100),
+			MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+			ChildCount: 2,
+			Direction:  layout.RightToLeft,
+			LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+				return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+			},
+		}
+		_, gs := l.Layout(ctx)
+		if gs[a.index].Frame != layout.Rt(70, 0, 90, 10) || gs[b.index].Frame != layout.Rt(45, 0, 65, 10) {
+			t.Errorf("%v: Incorrect frames: %v %v", strategy, gs[a.index].Frame, gs[b.index].Frame)
+		}
+		if len(l.Errors()) != 2 {
+			t.Errorf("%v: Expected errors for the mixed constraints: %v", strategy, l.Errors())
+		}
+	}
+}
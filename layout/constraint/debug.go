@@ -0,0 +1,271 @@
+package constraint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"golang.org/x/image/colornames"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/basicview"
+)
+
+// DebugInfo describes how a Layouter solved its guides during its most recent layout.
+type DebugInfo struct {
+	Strategy Strategy     `json:"strategy"`
+	Guides   []DebugGuide `json:"guides"`
+}
+
+// DebugGuide describes how a single guide was solved.
+type DebugGuide struct {
+	// Name identifies the guide. The layouter's guide is "Guide", and children are "Guide[0]", "Guide[1]"...
+	Name  string      `json:"name"`
+	Frame layout.Rect `json:"frame"`
+	// Constraints are listed in the order they were applied.
+	Constraints []DebugConstraint `json:"constraints"`
+	// Ranges are the allowed values of each attribute after applying the constraints, keyed by attribute name.
+	// They are only recorded by the Greedy strategy.
+	Ranges map[string]DebugRange `json:"ranges,omitempty"`
+}
+
+// DebugConstraint describes a single constraint and whether it was applied.
+type DebugConstraint struct {
+	Constraint string  `json:"constraint"`
+	Value      float64 `json:"value"`
+	Applied    bool    `json:"applied"`
+	// Reason explains why the constraint was ignored.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DebugRange is a range of allowed values. Min and Max may be infinite.
+type DebugRange struct {
+	Min float64
+	Max float64
+}
+
+func (r DebugRange) String() string {
+	return _range{min: r.Min, max: r.Max}.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface. Infinite bounds are encoded as null.
+func (r DebugRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Min *float64 `json:"min"`
+		Max *float64 `json:"max"`
+	}{finite(r.Min), finite(r.Max)})
+}
+
+// MarshalJSON implements the json.Marshaler interface. Infinite values are encoded as null.
+func (c DebugConstraint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Constraint string   `json:"constraint"`
+		Value      *float64 `json:"value"`
+		Applied    bool     `json:"applied"`
+		Reason     string   `json:"reason,omitempty"`
+	}{c.Constraint, finite(c.Value), c.Applied, c.Reason})
+}
+
+func finite(v float64) *float64 {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return nil
+	}
+	return &v
+}
+
+func (s Strategy) String() string {
+	switch s {
+	case Greedy:
+		return "Greedy"
+	case Cassowary:
+		return "Cassowary"
+	}
+	return ""
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s Strategy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// JSON returns d as indented JSON.
+func (d *DebugInfo) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// String returns a human readable description of d.
+func (d *DebugInfo) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "constraint.Layouter (%v)\n", d.Strategy)
+	for _, i := range d.Guides {
+		fmt.Fprintf(buf, "%v %v\n", i.Name, i.Frame)
+		for _, j := range i.Constraints {
+			if j.Applied {
+				fmt.Fprintf(buf, "  applied %v (%v)\n", j.Constraint, j.Value)
+			} else {
+				fmt.Fprintf(buf, "  ignored %v (%v): %v\n", j.Constraint, j.Value, j.Reason)
+			}
+		}
+		if i.Ranges != nil {
+			for _, j := range debugAttributes {
+				fmt.Fprintf(buf, "  %v %v\n", j, i.Ranges[j.String()])
+			}
+		}
+	}
+	return buf.String()
+}
+
+// Guide returns the debug info for the guide with the given name, or nil if none exists.
+func (d *DebugInfo) Guide(name string) *DebugGuide {
+	for idx := range d.Guides {
+		if d.Guides[idx].Name == name {
+			return &d.Guides[idx]
+		}
+	}
+	return nil
+}
+
+var debugAttributes = []attribute{leftAttr, rightAttr, topAttr, bottomAttr, widthAttr, heightAttr, centerXAttr, centerYAttr}
+
+func (cr constrainedRect) debugRanges() map[string]DebugRange {
+	m := map[string]DebugRange{}
+	for _, i := range debugAttributes {
+		r := cr.rangeFor(i)
+		m[i.String()] = DebugRange{Min: r.min, Max: r.max}
+	}
+	return m
+}
+
+func (cr constrainedRect) rangeFor(a attribute) _range {
+	switch a {
+	case leftAttr:
+		return cr.left
+	case rightAttr:
+		return cr.right
+	case topAttr:
+		return cr.top
+	case bottomAttr:
+		return cr.bottom
+	case widthAttr:
+		return cr.width
+	case heightAttr:
+		return cr.height
+	case centerXAttr:
+		return cr.centerX
+	case centerYAttr:
+		return cr.centerY
+	}
+	return _range{}
+}
+
+// invalidReason explains why cr is not valid.
+func (cr constrainedRect) invalidReason() string {
+	_, r1 := cr.solveWidth(0)
+	_, r2 := cr.solveHeight(0)
+	_, r3 := cr.solveCenterX(0)
+	_, r4 := cr.solveCenterY(0)
+	var a attribute
+	var r _range
+	switch {
+	case r1.width.max < r1.width.min:
+		a, r = widthAttr, r1.width
+	case r2.height.max < r2.height.min:
+		a, r = heightAttr, r2.height
+	case r3.centerX.max < r3.centerX.min:
+		a, r = centerXAttr, r3.centerX
+	default:
+		a, r = centerYAttr, r4.centerY
+	}
+	return fmt.Sprintf("conflicts with the previous constraints, %v would be in %v", a, r)
+}
+
+// Debug records how l solves each guide. The result is available from DebugInfo() after each layout.
+func (l *Layouter) Debug() {
+	l.debug = true
+}
+
+// DebugOverlay records how l solves each guide, and adds views to Views() that outline the guides.
+// It must be called before Views().
+func (l *Layouter) DebugOverlay() {
+	l.debug = true
+	l.debugOverlay = true
+}
+
+// DebugInfo returns how l solved its guides during the most recent layout, or nil if Debug() has not been called.
+func (l *Layouter) DebugInfo() *DebugInfo {
+	return l.debugInfo
+}
+
+func (l *Layouter) debugGuide(index int) *DebugGuide {
+	if l.debugInfo == nil {
+		return nil
+	}
+	name := guideName(index)
+	if g := l.debugInfo.Guide(name); g != nil {
+		return g
+	}
+	l.debugInfo.Guides = append(l.debugInfo.Guides, DebugGuide{Name: name, Constraints: []DebugConstraint{}})
+	return &l.debugInfo.Guides[len(l.debugInfo.Guides)-1]
+}
+
+// debugFrames records the final frame of every guide, and sorts the guides by index.
+func (l *Layouter) debugFrames() {
+	guides := []DebugGuide{}
+	for idx := -1; idx < len(l.Guide.children2); idx++ {
+		var g *layout.Guide
+		if idx == rootId {
+			g = l.Guide.matchaGuide
+		} else {
+			g = l.Guide.children2[idx].matchaGuide
+		}
+		dg := *l.debugGuide(idx)
+		if g != nil {
+			dg.Frame = g.Frame
+		}
+		guides = append(guides, dg)
+	}
+	l.debugInfo.Guides = guides
+}
+
+// overlayViews returns views that outline the layouter's guide and each child guide.
+func (l *Layouter) overlayViews() []view.View {
+	vs := []view.View{}
+	for i := 0; i < len(l.Guide.children2)+1; i++ {
+		color := colornames.Red
+		if i == 0 {
+			color = colornames.Blue
+		}
+		v := basicview.New()
+		v.Painter = &paint.Style{BorderColor: color, BorderWidth: 1}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// overlayGuides positions the overlay views above the children, over the layouter's guide and each child guide.
+func (l *Layouter) overlayGuides(ctx *layout.Context, g layout.Guide, gs []layout.Guide) []layout.Guide {
+	zIndex := 0
+	for _, i := range gs {
+		if i.ZIndex >= zIndex {
+			zIndex = i.ZIndex + 1
+		}
+	}
+	frames := []layout.Rect{layout.Rt(0, 0, g.Width(), g.Height())}
+	for _, i := range gs {
+		frames = append(frames, i.Frame)
+	}
+	count := len(gs)
+	for idx, i := range frames {
+		var o layout.Guide
+		if count+idx < ctx.ChildCount {
+			size := layout.Pt(i.Max.X-i.Min.X, i.Max.Y-i.Min.Y)
+			o = ctx.LayoutChild(count+idx, size, size)
+		}
+		o.Frame = i
+		o.ZIndex = zIndex + idx
+		gs = append(gs, o)
+	}
+	return gs
+}
@@ -0,0 +1,105 @@
+package constraint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+func TestDebugGreedy(t *testing.T) {
+	l := &Layouter{}
+	l.Debug()
+	_ = l.Add(nil, func(s *Solver) {
+		s.Width(100)
+		s.Width(200)
+		s.Height(10)
+	})
+	layoutConstraints(l, []layout.Point{{}}, layout.Pt(300, 100))
+
+	info := l.DebugInfo()
+	if info == nil || len(info.Guides) != 2 || info.Guides[0].Name != "Guide" {
+		t.Fatalf("Incorrect guides: %v", info)
+	}
+	g := info.Guide("Guide[0]")
+	if g.Frame != layout.Rt(100, 45, 200, 55) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if len(g.Constraints) != 3 || !g.Constraints[0].Applied || g.Constraints[1].Applied || !g.Constraints[2].Applied {
+		t.Fatalf("Incorrect constraints: %v", g.Constraints)
+	}
+	if c := g.Constraints[1]; c.Constraint != "Guide[0].Width = 200" || c.Reason != "conflicts with the previous constraints, Width would be in [200, 100]" {
+		t.Errorf("Incorrect ignored constraint: %v", c)
+	}
+	if r := g.Ranges["Width"]; r != (DebugRange{100, 100}) {
+		t.Errorf("Incorrect width range: %v", r)
+	}
+	if str := info.String(); !strings.Contains(str, "ignored Guide[0].Width = 200 (200)") {
+		t.Errorf("Incorrect description: %v", str)
+	}
+
+	data, err := info.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m struct {
+		Strategy string
+		Guides   []struct {
+			Ranges map[string]map[string]*float64
+		}
+	}
+	if err := json.Unmarshal(data, &m); err != nil || m.Strategy != "Greedy" {
+		t.Fatalf("Incorrect JSON: %s", data)
+	}
+	if r := m.Guides[1].Ranges["Left"]; r["min"] != nil || r["max"] != nil {
+		t.Errorf("Infinite ranges are not null: %v", r)
+	}
+	if r := m.Guides[1].Ranges["Width"]; r["min"] == nil || *r["min"] != 100 {
+		t.Errorf("Incorrect width range: %v", r)
+	}
+}
+
+func TestDebugCassowary(t *testing.T) {
+	l := &Layouter{Strategy: Cassowary}
+	l.Debug()
+	_ = l.Add(nil, func(s *Solver) {
+		s.Width(100)
+		s.Width(200)
+	})
+	layoutConstraints(l, []layout.Point{{}}, layout.Pt(300, 100))
+
+	g := l.DebugInfo().Guide("Guide[0]")
+	if len(g.Constraints) != 2 || !g.Constraints[0].Applied || g.Constraints[1].Applied {
+		t.Fatalf("Incorrect constraints: %v", g.Constraints)
+	}
+	if c := g.Constraints[1]; c.Value != 200 || !strings.Contains(c.Reason, "conflicts with [Guide[0].Width = 100]") {
+		t.Errorf("Incorrect ignored constraint: %v", c)
+	}
+	if g.Ranges != nil {
+		t.Errorf("Unexpected ranges: %v", g.Ranges)
+	}
+}
+
+func TestDebugOverlay(t *testing.T) {
+	l := &Layouter{}
+	l.DebugOverlay()
+	_ = l.Add(nil, func(s *Solver) {
+		s.Width(10)
+		s.Height(10)
+	})
+	if len(l.Views()) != 3 {
+		t.Fatalf("Incorrect number of views: %v", len(l.Views()))
+	}
+
+	_, gs := layoutConstraints(l, []layout.Point{{}, {}, {}}, layout.Pt(100, 100))
+	if len(gs) != 3 {
+		t.Fatalf("Incorrect number of guides: %v", len(gs))
+	}
+	if gs[1].Frame != layout.Rt(0, 0, 100, 100) || gs[2].Frame != gs[0].Frame {
+		t.Errorf("Incorrect overlay frames: %v", gs)
+	}
+	if gs[1].ZIndex <= gs[0].ZIndex || gs[2].ZIndex <= gs[0].ZIndex {
+		t.Errorf("Overlays are not above the children: %v", gs)
+	}
+}
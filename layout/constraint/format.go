@@ -0,0 +1,476 @@
+package constraint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gomatcha.io/matcha/view"
+)
+
+// DefaultSpacing is the spacing used by a visual format connection without a size, as in "[a]-[b]".
+const DefaultSpacing = 8
+
+// FormatError is returned when a visual format string cannot be parsed.
+type FormatError struct {
+	// Format is the format string that failed to parse.
+	Format string
+	// Offset is the byte offset into Format of the error.
+	Offset int
+	// Message describes the error.
+	Message string
+}
+
+// Error returns the message, followed by the format string with the offending position marked.
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("constraint: %v at offset %v\n\t%v\n\t%v^", e.Message, e.Offset, e.Format, strings.Repeat(" ", e.Offset))
+}
+
+// formatConstraint is a single constraint generated from a format string. It constrains the attribute of view to the
+// target's attribute, plus a constant.
+type formatConstraint struct {
+	view       string
+	attribute  attribute
+	comparison comparison
+	// target is the name of a view, "|" for the layouter's guide, or "" for a constant.
+	target          string
+	targetAttribute attribute
+	constant        float64
+	priority        Priority
+	// format and offset locate the constraint, for reporting errors.
+	format string
+	offset int
+}
+
+type formatPredicate struct {
+	comparison comparison
+	view       string
+	viewOffset int
+	constant   float64
+	priority   Priority
+}
+
+type formatParser struct {
+	format  string
+	pos     int
+	views   map[string]view.View
+	metrics map[string]float64
+
+	leading, trailing, size attribute
+	names                   []string
+	constraints             []formatConstraint
+}
+
+func (p *formatParser) errorf(offset int, format string, args ...interface{}) error {
+	return &FormatError{Format: p.format, Offset: offset, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *formatParser) eof() bool {
+	return p.pos >= len(p.format)
+}
+
+func (p *formatParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.format[p.pos]
+}
+
+func (p *formatParser) consume(s string) bool {
+	if strings.HasPrefix(p.format[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *formatParser) expect(s string) error {
+	if !p.consume(s) {
+		if p.eof() {
+			return p.errorf(p.pos, "expected '%v', found end of format", s)
+		}
+		return p.errorf(p.pos, "expected '%v', found '%c'", s, p.peek())
+	}
+	return nil
+}
+
+func isIdentifier(c byte, first bool) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (!first && c >= '0' && c <= '9')
+}
+
+func (p *formatParser) identifier() string {
+	start := p.pos
+	for !p.eof() && isIdentifier(p.peek(), p.pos == start) {
+		p.pos += 1
+	}
+	return p.format[start:p.pos]
+}
+
+func (p *formatParser) number() (float64, bool) {
+	start := p.pos
+	if p.peek() == '-' || p.peek() == '+' {
+		p.pos += 1
+	}
+	for !p.eof() && (p.peek() == '.' || (p.peek() >= '0' && p.peek() <= '9')) {
+		p.pos += 1
+	}
+	v, err := strconv.ParseFloat(p.format[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		return 0, false
+	}
+	return v, true
+}
+
+func (p *formatParser) parse() error {
+	p.leading, p.trailing, p.size = leadingAttr, trailingAttr, widthAttr
+	if p.consume("V:") {
+		p.leading, p.trailing, p.size = topAttr, bottomAttr, heightAttr
+	} else {
+		p.consume("H:")
+	}
+
+	prev := ""
+	if p.consume("|") {
+		prev = "|"
+	}
+	if prev == "" && p.peek() != '[' {
+		return p.errorf(p.pos, "expected '[' or '|'")
+	}
+
+	for {
+		connectionOffset := p.pos
+		connection, err := p.connection(prev != "")
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case p.peek() == '[':
+			name, err := p.view()
+			if err != nil {
+				return err
+			}
+			if prev != "" {
+				p.connect(prev, name, connection, connectionOffset)
+			}
+			prev = name
+		case p.peek() == '|' && prev != "|":
+			p.pos += 1
+			p.connect(prev, "|", connection, connectionOffset)
+			if !p.eof() {
+				return p.errorf(p.pos, "unexpected '%c' after '|'", p.peek())
+			}
+			return nil
+		case p.eof() && connection == nil:
+			return nil
+		case p.eof():
+			return p.errorf(connectionOffset, "connection must be followed by a view or '|'")
+		default:
+			return p.errorf(p.pos, "unexpected '%c'", p.peek())
+		}
+	}
+}
+
+// connection parses the spacing between two items. A nil result means there is no connection.
+func (p *formatParser) connection(hasPrevious bool) ([]formatPredicate, error) {
+	if !p.consume("-") {
+		if hasPrevious && (p.peek() == '[' || p.peek() == '|') {
+			return []formatPredicate{{comparison: equal}}, nil // Flush.
+		}
+		return nil, nil
+	}
+	if !hasPrevious {
+		return nil, p.errorf(p.pos-1, "connection must follow a view or '|'")
+	}
+	if p.peek() == '[' || p.peek() == '|' {
+		return []formatPredicate{{comparison: equal, constant: DefaultSpacing}}, nil
+	}
+
+	var preds []formatPredicate
+	if p.peek() == '(' {
+		var err error
+		if preds, err = p.predicateList(false); err != nil {
+			return nil, err
+		}
+	} else {
+		pred, err := p.predicate(false)
+		if err != nil {
+			return nil, err
+		}
+		preds = []formatPredicate{pred}
+	}
+	if err := p.expect("-"); err != nil {
+		return nil, err
+	}
+	return preds, nil
+}
+
+func (p *formatParser) view() (string, error) {
+	p.pos += 1 // [
+	offset := p.pos
+	name := p.identifier()
+	if name == "" {
+		return "", p.errorf(p.pos, "expected view name")
+	}
+	if _, ok := p.views[name]; !ok {
+		return "", p.errorf(offset, "unknown view '%v'", name)
+	}
+	for _, i := range p.names {
+		if i == name {
+			return "", p.errorf(offset, "view '%v' appears more than once", name)
+		}
+	}
+	p.names = append(p.names, name)
+
+	if p.peek() == '(' {
+		preds, err := p.predicateList(true)
+		if err != nil {
+			return "", err
+		}
+		for _, i := range preds {
+			c := formatConstraint{view: name, attribute: p.size, comparison: i.comparison, constant: i.constant, priority: i.priority, format: p.format, offset: i.viewOffset}
+			if i.view != "" {
+				if i.view == name {
+					return "", p.errorf(i.viewOffset, "view '%v' cannot be sized relative to itself", name)
+				}
+				c.target = i.view
+				c.targetAttribute = p.size
+			}
+			p.constraints = append(p.constraints, c)
+		}
+	}
+	if err := p.expect("]"); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func (p *formatParser) predicateList(allowViews bool) ([]formatPredicate, error) {
+	p.pos += 1 // (
+	preds := []formatPredicate{}
+	for {
+		pred, err := p.predicate(allowViews)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+		if !p.consume(",") {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return preds, nil
+}
+
+func (p *formatParser) predicate(allowViews bool) (formatPredicate, error) {
+	pred := formatPredicate{comparison: equal}
+	switch {
+	case p.consume("=="):
+	case p.consume(">="):
+		pred.comparison = greater
+	case p.consume("<="):
+		pred.comparison = less
+	}
+
+	offset := p.pos
+	if v, ok := p.number(); ok {
+		pred.constant = v
+	} else if name := p.identifier(); name != "" {
+		if v, ok := p.metrics[name]; ok {
+			pred.constant = v
+		} else if _, ok := p.views[name]; ok && allowViews {
+			pred.view = name
+			pred.viewOffset = offset
+		} else if ok {
+			return pred, p.errorf(offset, "view '%v' cannot be used as a spacing", name)
+		} else {
+			return pred, p.errorf(offset, "unknown metric '%v'", name)
+		}
+	} else if p.eof() {
+		return pred, p.errorf(p.pos, "expected a number or name, found end of format")
+	} else {
+		return pred, p.errorf(p.pos, "expected a number or name, found '%c'", p.peek())
+	}
+
+	if p.consume("@") {
+		offset := p.pos
+		switch name := p.identifier(); name {
+		case "required":
+			pred.priority = Required
+		case "strong":
+			pred.priority = Strong
+		case "weak":
+			pred.priority = Weak
+		default:
+			return pred, p.errorf(offset, "unknown priority '%v', expected required, strong or weak", name)
+		}
+	}
+	return pred, nil
+}
+
+// connect adds the constraints for the spacing between prev and next, which are view names or "|". Offset is the
+// position of the connection.
+func (p *formatParser) connect(prev, next string, preds []formatPredicate, offset int) {
+	for _, i := range preds {
+		if next == "|" {
+			// The spacing is measured back from the layouter's trailing edge, so inequalities are reversed.
+			comparison := i.comparison
+			switch comparison {
+			case greater:
+				comparison = less
+			case less:
+				comparison = greater
+			}
+			p.constraints = append(p.constraints, formatConstraint{
+				view: prev, attribute: p.trailing, comparison: comparison,
+				target: "|", targetAttribute: p.trailing, constant: -i.constant, priority: i.priority,
+				format: p.format, offset: offset,
+			})
+			continue
+		}
+
+		c := formatConstraint{
+			view: next, attribute: p.leading, comparison: i.comparison,
+			target: prev, targetAttribute: p.trailing, constant: i.constant, priority: i.priority,
+			format: p.format, offset: offset,
+		}
+		if prev == "|" {
+			c.targetAttribute = p.leading
+		}
+		p.constraints = append(p.constraints, c)
+	}
+}
+
+// AddFormat adds views to l and positions them with visual format strings. Each view is referred to by its key in
+// views, and metrics can be used in place of numbers.
+//
+//	guides, err := l.AddFormat(map[string]view.View{"a": a, "b": b}, map[string]float64{"margin": 8},
+//		"H:|-margin-[a(>=40)]-[b(==a)]-margin-|",
+//		"V:|-[a]",
+//		"V:|-[b]",
+//	)
+//
+// A format string describes a horizontal (H:, the default) or vertical (V:) row of views. Views are written as
+// [name] and may be followed by a list of size predicates, as in [a(>=40,<=100)] or [b(==a)]. Views are separated by
+// connections: - for DefaultSpacing, -10- for explicit spacing, -(>=10)- for predicates, or nothing to make them flush.
+// | is the edge of l's guide. Predicates can end with a priority, as in -(>=10@weak)-. Horizontal rows run from the
+// leading edge to the trailing edge, so they are mirrored in a right-to-left layout direction.
+//
+// Views are added in an order where each view follows the views it is positioned against, if possible, and the guide
+// for each view is returned. Views that do not appear in any format string are added unconstrained. If any format
+// string is invalid, or if views depend on each other in a cycle and l does not use the Cassowary strategy, nothing is
+// added and a *FormatError is returned.
+func (l *Layouter) AddFormat(views map[string]view.View, metrics map[string]float64, formats ...string) (map[string]*Guide, error) {
+	l.initialize()
+
+	names := []string{}
+	constraints := []formatConstraint{}
+	for _, i := range formats {
+		p := &formatParser{format: i, views: views, metrics: metrics}
+		if err := p.parse(); err != nil {
+			return nil, err
+		}
+		for _, j := range p.names {
+			if !containsString(names, j) {
+				names = append(names, j)
+			}
+		}
+		constraints = append(constraints, p.constraints...)
+	}
+	unused := []string{}
+	for k := range views {
+		if !containsString(names, k) {
+			unused = append(unused, k)
+		}
+	}
+	sort.Strings(unused)
+	names = append(names, unused...)
+
+	// Order the views so that each follows the views it depends on, preferring the order they appear in.
+	dependencies := map[string][]string{}
+	for _, i := range constraints {
+		if i.target != "" && i.target != "|" {
+			dependencies[i.view] = append(dependencies[i.view], i.target)
+		}
+	}
+	order := []string{}
+	for len(order) < len(names) {
+		next := ""
+		for _, i := range names {
+			if containsString(order, i) {
+				continue
+			}
+			ready := true
+			for _, j := range dependencies[i] {
+				if !containsString(order, j) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next == "" {
+			// There is a cycle. The Greedy strategy solves each view after the views it depends on, so it cannot lay
+			// them out. Otherwise fall back to the order the views appear in.
+			for _, i := range names {
+				if !containsString(order, i) {
+					next = i
+					break
+				}
+			}
+			if l.Strategy != Cassowary {
+				for _, i := range constraints {
+					if i.view == next && i.target != "" && i.target != "|" && !containsString(order, i.target) {
+						return nil, &FormatError{Format: i.format, Offset: i.offset, Message: fmt.Sprintf("views '%v' and '%v' depend on each other, which requires the Cassowary strategy", i.view, i.target)}
+					}
+				}
+			}
+		}
+		order = append(order, next)
+	}
+
+	// Guides are referenced by index, so anchors can refer to guides that have not been added yet.
+	indexes := map[string]int{}
+	for idx, i := range order {
+		indexes[i] = len(l.Guide.children2) + idx
+	}
+	anchorFor := func(name string, a attribute) anchor {
+		if name == "|" {
+			return guideAnchor{guide: &l.Guide, attribute: a}
+		}
+		return guideAnchor{guide: &Guide{index: indexes[name], system: l}, attribute: a}
+	}
+
+	guides := map[string]*Guide{}
+	for _, name := range order {
+		guides[name] = l.Add(views[name], func(s *Solver) {
+			for _, i := range constraints {
+				if i.view != name {
+					continue
+				}
+				var a anchor = constAnchor(i.constant)
+				if i.target != "" {
+					a = offsetAnchor{offset: i.constant, underlying: anchorFor(i.target, i.targetAttribute)}
+				}
+				s.constraints = append(s.constraints, constraint{attribute: i.attribute, comparison: i.comparison, anchor: a, priority: i.priority})
+			}
+		})
+	}
+	return guides, nil
+}
+
+func containsString(s []string, v string) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package constraint
+
+import (
+	"math"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/basicview"
+)
+
+func TestFormat(t *testing.T) {
+	views := map[string]view.View{"a": basicview.New(), "b": basicview.New(), "c": basicview.New()}
+	l := &Layouter{Strategy: Cassowary}
+	guides, err := l.AddFormat(views, map[string]float64{"margin": 10},
+		"H:|-margin-[a(>=40)]-[b(==a)]-margin-|",
+		"V:|[a(20)]-(>=5@weak)-[c(30)]-20-|",
+		"V:|-[b(20)]",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(guides) != 3 || len(l.Views()) != 3 {
+		t.Fatalf("Incorrect guides: %v", guides)
+	}
+
+	_, gs := layoutConstraints(l, []layout.Point{{}, {}, {}}, layout.Pt(200, 100))
+	frames := map[string]layout.Rect{}
+	for k, v := range guides {
+		frames[k] = gs[v.index].Frame
+	}
+	if frames["a"] != layout.Rt(10, 0, 96, 20) {
+		t.Errorf("Incorrect frame for a: %v", frames["a"])
+	}
+	if frames["b"] != layout.Rt(104, 8, 190, 28) {
+		t.Errorf("Incorrect frame for b: %v", frames["b"])
+	}
+	if frames["c"].Min.Y != 50 || frames["c"].Max.Y != 80 {
+		t.Errorf("Incorrect frame for c: %v", frames["c"])
+	}
+	if len(l.Errors()) != 0 {
+		t.Errorf("Unexpected errors: %v", l.Errors())
+	}
+}
+
+func TestFormatRightToLeft(t *testing.T) {
+	views := map[string]view.View{"a": basicview.New(), "b": basicview.New()}
+	l := &Layouter{Strategy: Cassowary}
+	guides, err := l.AddFormat(views, nil, "H:|-10-[a(20)]-[b]-10-|", "V:|[a(10)]", "V:|[b(10)]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &layout.Context{
+		MinSize:    layout.Pt(100, 100),
+		MaxSize:    layout.Pt(math.Inf(1), math.Inf(1)),
+		ChildCount: 2,
+		Direction:  layout.RightToLeft,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+		},
+	}
+	_, gs := l.Layout(ctx)
+	if f := gs[guides["a"].index].Frame; f != layout.Rt(70, 0, 90, 10) {
+		t.Errorf("Incorrect frame for a: %v", f)
+	}
+	if f := gs[guides["b"].index].Frame; f != layout.Rt(10, 0, 62, 10) {
+		t.Errorf("Incorrect frame for b: %v", f)
+	}
+}
+
+func TestFormatOrder(t *testing.T) {
+	views := map[string]view.View{"a": basicview.New(), "b": basicview.New()}
+	l := &Layouter{}
+	guides, err := l.AddFormat(views, nil, "[a(==b)]", "[b(10)]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a depends on b, so b is added first.
+	if guides["b"].index != 0 || guides["a"].index != 1 {
+		t.Errorf("Incorrect order: a:%v b:%v", guides["a"].index, guides["b"].index)
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	views := map[string]view.View{"a": basicview.New(), "b": basicview.New()}
+	cases := []struct {
+		format string
+		offset int
+		err    string
+	}{
+		{"H:|-8-[a(>=40]", 13, "constraint: expected ')', found ']' at offset 13\n\tH:|-8-[a(>=40]\n\t             ^"},
+		{"|-[x]", 3, ""},
+		{"|-[a]-[a]", 7, ""},
+		{"|-foo-[a]", 2, ""},
+		{"[a(==a)]", 5, ""},
+		{"|-a-[b]", 2, ""},
+		{"[a]-", 4, ""},
+		{"[a]|x", 4, ""},
+		{"[a(10@often)]", 6, ""},
+		{"a", 0, ""},
+		{"-[a]", 0, ""},
+	}
+	for _, i := range cases {
+		l := &Layouter{}
+		_, err := l.AddFormat(views, nil, i.format)
+		ferr, ok := err.(*FormatError)
+		if !ok {
+			t.Errorf("%v: expected FormatError, got %v", i.format, err)
+			continue
+		}
+		if ferr.Offset != i.offset {
+			t.Errorf("%v: incorrect offset %v, expected %v: %v", i.format, ferr.Offset, i.offset, ferr)
+		}
+		if i.err != "" && ferr.Error() != i.err {
+			t.Errorf("%v: incorrect error %q", i.format, ferr.Error())
+		}
+		if len(l.Views()) != 0 {
+			t.Errorf("%v: views were added", i.format)
+		}
+	}
+}
+
+func TestFormatCycle(t *testing.T) {
+	views := map[string]view.View{"a": basicview.New(), "b": basicview.New()}
+	formats := []string{"H:|-[a(==b)]-[b]-|", "V:|[a]|", "V:|[b]|"}
+
+	// a is sized against b, which is positioned against a, so the Greedy strategy cannot solve them in order.
+	l := &Layouter{}
+	_, err := l.AddFormat(views, nil, formats...)
+	if ferr, ok := err.(*FormatError); !ok || ferr.Format != formats[0] || ferr.Offset != 9 {
+		t.Errorf("Expected a FormatError for the cycle: %v", err)
+	}
+	if len(l.Views()) != 0 {
+		t.Errorf("Views were added")
+	}
+
+	l = &Layouter{Strategy: Cassowary}
+	guides, err := l.AddFormat(views, nil, formats...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, gs := layoutConstraints(l, []layout.Point{{}, {}}, layout.Pt(100, 100))
+	if f := gs[guides["a"].index].Frame; f != layout.Rt(8, 0, 46, 100) {
+		t.Errorf("Incorrect frame for a: %v", f)
+	}
+	if f := gs[guides["b"].index].Frame; f != layout.Rt(54, 0, 92, 100) {
+		t.Errorf("Incorrect frame for b: %v", f)
+	}
+	if len(l.Errors()) != 0 {
+		t.Errorf("Unexpected errors: %v", l.Errors())
+	}
+}
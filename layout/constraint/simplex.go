@@ -0,0 +1,679 @@
+package constraint
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// This file implements an incremental Cassowary solver, based on the dual simplex method. Constraints can be added
+// and removed, and edit variables can be suggested new values, without resolving the whole system.
+
+type symbolKind int
+
+const (
+	invalidSymbol symbolKind = iota
+	externalSymbol
+	slackSymbol
+	errorSymbol
+	dummySymbol
+)
+
+type symbol struct {
+	id   int
+	kind symbolKind
+}
+
+func (s symbol) valid() bool {
+	return s.kind != invalidSymbol
+}
+
+// variable is an unknown that is solved by the simplex.
+type variable struct {
+	name  string
+	value float64
+}
+
+// term is a variable multiplied by a coefficient.
+type term struct {
+	variable    *variable
+	coefficient float64
+}
+
+// linear is the expression Σ(terms) + constant.
+type linear struct {
+	terms    []term
+	constant float64
+}
+
+func (e linear) add(e2 linear) linear {
+	terms := make([]term, 0, len(e.terms)+len(e2.terms))
+	terms = append(terms, e.terms...)
+	terms = append(terms, e2.terms...)
+	return linear{terms: terms, constant: e.constant + e2.constant}
+}
+
+func (e linear) mul(v float64) linear {
+	terms := make([]term, len(e.terms))
+	for idx, i := range e.terms {
+		terms[idx] = term{variable: i.variable, coefficient: i.coefficient * v}
+	}
+	return linear{terms: terms, constant: e.constant * v}
+}
+
+func (e linear) sub(e2 linear) linear {
+	return e.add(e2.mul(-1))
+}
+
+// value evaluates e with the current values of its variables.
+func (e linear) value() float64 {
+	v := e.constant
+	for _, i := range e.terms {
+		v += i.variable.value * i.coefficient
+	}
+	return v
+}
+
+// simplexConstraint is the relation `expression comparison 0`, with a strength.
+type simplexConstraint struct {
+	expression linear
+	comparison comparison
+	strength   float64
+}
+
+type tag struct {
+	marker symbol
+	other  symbol
+}
+
+type editInfo struct {
+	tag        tag
+	constraint *simplexConstraint
+	constant   float64
+}
+
+type row struct {
+	constant float64
+	cells    map[symbol]float64
+}
+
+func newRow(constant float64) *row {
+	return &row{constant: constant, cells: map[symbol]float64{}}
+}
+
+func (r *row) copy() *row {
+	r2 := newRow(r.constant)
+	for k, v := range r.cells {
+		r2.cells[k] = v
+	}
+	return r2
+}
+
+// symbols returns the symbols of r in a stable order, so that pivots are deterministic.
+func (r *row) symbols() []symbol {
+	syms := make([]symbol, 0, len(r.cells))
+	for k := range r.cells {
+		syms = append(syms, k)
+	}
+	sortSymbols(syms)
+	return syms
+}
+
+func (r *row) add(v float64) float64 {
+	r.constant += v
+	return r.constant
+}
+
+func (r *row) insertSymbol(s symbol, coefficient float64) {
+	v := r.cells[s] + coefficient
+	if nearZero(v) {
+		delete(r.cells, s)
+	} else {
+		r.cells[s] = v
+	}
+}
+
+func (r *row) insertRow(r2 *row, coefficient float64) {
+	r.constant += r2.constant * coefficient
+	for k, v := range r2.cells {
+		r.insertSymbol(k, v*coefficient)
+	}
+}
+
+func (r *row) remove(s symbol) {
+	delete(r.cells, s)
+}
+
+func (r *row) reverseSign() {
+	r.constant = -r.constant
+	for k, v := range r.cells {
+		r.cells[k] = -v
+	}
+}
+
+// solveFor solves the row for s, assuming the row is equal to 0.
+func (r *row) solveFor(s symbol) {
+	coefficient := -1 / r.cells[s]
+	delete(r.cells, s)
+	r.constant *= coefficient
+	for k, v := range r.cells {
+		r.cells[k] = v * coefficient
+	}
+}
+
+// solveForPair solves the row `lhs = r` for rhs.
+func (r *row) solveForPair(lhs, rhs symbol) {
+	r.insertSymbol(lhs, -1)
+	r.solveFor(rhs)
+}
+
+func (r *row) coefficientFor(s symbol) float64 {
+	return r.cells[s]
+}
+
+func (r *row) substitute(s symbol, r2 *row) {
+	if v, ok := r.cells[s]; ok {
+		delete(r.cells, s)
+		r.insertRow(r2, v)
+	}
+}
+
+var (
+	errUnsatisfiable    = errors.New("constraint: unsatisfiable constraint")
+	errDuplicate        = errors.New("constraint: duplicate constraint")
+	errUnknown          = errors.New("constraint: unknown constraint")
+	errUnbounded        = errors.New("constraint: objective is unbounded")
+	errDuplicateEdit    = errors.New("constraint: duplicate edit variable")
+	errRequiredEdit     = errors.New("constraint: edit variables cannot be required")
+	errUnknownEdit      = errors.New("constraint: unknown edit variable")
+	errDualOptimization = errors.New("constraint: dual optimization failed")
+)
+
+// simplex is an incremental Cassowary solver.
+type simplex struct {
+	constraints    map[*simplexConstraint]tag
+	rows           map[symbol]*row
+	vars           map[*variable]symbol
+	edits          map[*variable]*editInfo
+	infeasibleRows []symbol
+	objective      *row
+	artificial     *row
+	maxId          int
+}
+
+func newSimplex() *simplex {
+	return &simplex{
+		constraints: map[*simplexConstraint]tag{},
+		rows:        map[symbol]*row{},
+		vars:        map[*variable]symbol{},
+		edits:       map[*variable]*editInfo{},
+		objective:   newRow(0),
+	}
+}
+
+func (s *simplex) newSymbol(k symbolKind) symbol {
+	s.maxId += 1
+	return symbol{id: s.maxId, kind: k}
+}
+
+// addConstraint adds c to the system. It returns errUnsatisfiable if c is required and conflicts with the
+// existing required constraints, in which case the system is left unchanged.
+func (s *simplex) addConstraint(c *simplexConstraint) error {
+	if _, ok := s.constraints[c]; ok {
+		return errDuplicate
+	}
+
+	saved := s.save()
+	if err := s.insertConstraint(c); err != nil {
+		s.restore(saved)
+		return err
+	}
+	return nil
+}
+
+func (s *simplex) insertConstraint(c *simplexConstraint) error {
+	t, r := s.createRow(c)
+	subject := chooseSubject(r, t)
+
+	if !subject.valid() && allDummies(r) {
+		if !nearZero(r.constant) {
+			return errUnsatisfiable
+		}
+		subject = t.marker
+	}
+
+	if !subject.valid() {
+		ok, err := s.addWithArtificialVariable(r)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errUnsatisfiable
+		}
+	} else {
+		r.solveFor(subject)
+		s.substitute(subject, r)
+		s.rows[subject] = r
+	}
+
+	s.constraints[c] = t
+	return s.optimize(s.objective)
+}
+
+// simplexState is a copy of the tableau, so that a failed insertion can be undone.
+type simplexState struct {
+	rows           map[symbol]*row
+	vars           map[*variable]symbol
+	objective      *row
+	infeasibleRows []symbol
+}
+
+func (s *simplex) save() simplexState {
+	st := simplexState{
+		rows:           make(map[symbol]*row, len(s.rows)),
+		vars:           make(map[*variable]symbol, len(s.vars)),
+		objective:      s.objective.copy(),
+		infeasibleRows: append([]symbol(nil), s.infeasibleRows...),
+	}
+	for k, v := range s.rows {
+		st.rows[k] = v.copy()
+	}
+	for k, v := range s.vars {
+		st.vars[k] = v
+	}
+	return st
+}
+
+func (s *simplex) restore(st simplexState) {
+	s.rows = st.rows
+	s.vars = st.vars
+	s.objective = st.objective
+	s.infeasibleRows = st.infeasibleRows
+	s.artificial = nil
+}
+
+// removeConstraint removes c from the system.
+func (s *simplex) removeConstraint(c *simplexConstraint) error {
+	t, ok := s.constraints[c]
+	if !ok {
+		return errUnknown
+	}
+	delete(s.constraints, c)
+	s.removeObjectiveEffects(c, t)
+
+	if _, ok := s.rows[t.marker]; ok {
+		delete(s.rows, t.marker)
+	} else {
+		leaving, ok := s.markerLeavingRow(t.marker)
+		if !ok {
+			return errUnknown
+		}
+		r := s.rows[leaving]
+		delete(s.rows, leaving)
+		r.solveForPair(leaving, t.marker)
+		s.substitute(t.marker, r)
+	}
+	return s.optimize(s.objective)
+}
+
+// addEditVariable allows the value of v to be suggested with suggestValue.
+func (s *simplex) addEditVariable(v *variable, strength float64) error {
+	if _, ok := s.edits[v]; ok {
+		return errDuplicateEdit
+	}
+	if strength >= requiredStrength {
+		return errRequiredEdit
+	}
+	c := &simplexConstraint{
+		expression: linear{terms: []term{{variable: v, coefficient: 1}}},
+		comparison: equal,
+		strength:   strength,
+	}
+	if err := s.addConstraint(c); err != nil {
+		return err
+	}
+	s.edits[v] = &editInfo{tag: s.constraints[c], constraint: c}
+	return nil
+}
+
+// suggestValue updates the desired value of the edit variable v.
+func (s *simplex) suggestValue(v *variable, value float64) error {
+	info, ok := s.edits[v]
+	if !ok {
+		return errUnknownEdit
+	}
+	delta := value - info.constant
+	info.constant = value
+
+	if r, ok := s.rows[info.tag.marker]; ok {
+		if r.add(-delta) < 0 {
+			s.infeasibleRows = append(s.infeasibleRows, info.tag.marker)
+		}
+		return s.dualOptimize()
+	}
+	if r, ok := s.rows[info.tag.other]; ok {
+		if r.add(delta) < 0 {
+			s.infeasibleRows = append(s.infeasibleRows, info.tag.other)
+		}
+		return s.dualOptimize()
+	}
+	for _, sym := range s.rowSymbols() {
+		r := s.rows[sym]
+		coefficient := r.coefficientFor(info.tag.marker)
+		if coefficient != 0 && r.add(delta*coefficient) < 0 && sym.kind != externalSymbol {
+			s.infeasibleRows = append(s.infeasibleRows, sym)
+		}
+	}
+	return s.dualOptimize()
+}
+
+// updateVariables copies the solution into the value of each variable.
+func (s *simplex) updateVariables() {
+	for v, sym := range s.vars {
+		if r, ok := s.rows[sym]; ok {
+			v.value = r.constant
+		} else {
+			v.value = 0
+		}
+	}
+}
+
+func (s *simplex) symbolFor(v *variable) symbol {
+	if sym, ok := s.vars[v]; ok {
+		return sym
+	}
+	sym := s.newSymbol(externalSymbol)
+	s.vars[v] = sym
+	return sym
+}
+
+// createRow returns a new row for c, with the slack and error variables for its comparison and strength.
+func (s *simplex) createRow(c *simplexConstraint) (tag, *row) {
+	t := tag{}
+	r := newRow(c.expression.constant)
+	for _, i := range c.expression.terms {
+		if nearZero(i.coefficient) {
+			continue
+		}
+		sym := s.symbolFor(i.variable)
+		if r2, ok := s.rows[sym]; ok {
+			r.insertRow(r2, i.coefficient)
+		} else {
+			r.insertSymbol(sym, i.coefficient)
+		}
+	}
+
+	switch c.comparison {
+	case less, greater:
+		coefficient := 1.0
+		if c.comparison == greater {
+			coefficient = -1.0
+		}
+		slack := s.newSymbol(slackSymbol)
+		t.marker = slack
+		r.insertSymbol(slack, coefficient)
+		if c.strength < requiredStrength {
+			e := s.newSymbol(errorSymbol)
+			t.other = e
+			r.insertSymbol(e, -coefficient)
+			s.objective.insertSymbol(e, c.strength)
+		}
+	case equal:
+		if c.strength < requiredStrength {
+			plus := s.newSymbol(errorSymbol)
+			minus := s.newSymbol(errorSymbol)
+			t.marker = plus
+			t.other = minus
+			r.insertSymbol(plus, -1)
+			r.insertSymbol(minus, 1)
+			s.objective.insertSymbol(plus, c.strength)
+			s.objective.insertSymbol(minus, c.strength)
+		} else {
+			dummy := s.newSymbol(dummySymbol)
+			t.marker = dummy
+			r.insertSymbol(dummy, 1)
+		}
+	}
+
+	if r.constant < 0 {
+		r.reverseSign()
+	}
+	return t, r
+}
+
+// chooseSubject returns the symbol to solve r for, or an invalid symbol if the artificial variable technique is needed.
+func chooseSubject(r *row, t tag) symbol {
+	for _, i := range r.symbols() {
+		if i.kind == externalSymbol {
+			return i
+		}
+	}
+	if t.marker.kind == slackSymbol || t.marker.kind == errorSymbol {
+		if r.coefficientFor(t.marker) < 0 {
+			return t.marker
+		}
+	}
+	if t.other.kind == slackSymbol || t.other.kind == errorSymbol {
+		if r.coefficientFor(t.other) < 0 {
+			return t.other
+		}
+	}
+	return symbol{}
+}
+
+func allDummies(r *row) bool {
+	for k := range r.cells {
+		if k.kind != dummySymbol {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *simplex) addWithArtificialVariable(r *row) (bool, error) {
+	art := s.newSymbol(slackSymbol)
+	s.rows[art] = r.copy()
+	s.artificial = r.copy()
+
+	if err := s.optimize(s.artificial); err != nil {
+		s.artificial = nil
+		return false, err
+	}
+	success := nearZero(s.artificial.constant)
+	s.artificial = nil
+
+	if r2, ok := s.rows[art]; ok {
+		delete(s.rows, art)
+		if len(r2.cells) == 0 {
+			return success, nil
+		}
+		entering := anyPivotableSymbol(r2)
+		if !entering.valid() {
+			return false, nil
+		}
+		r2.solveForPair(art, entering)
+		s.substitute(entering, r2)
+		s.rows[entering] = r2
+	}
+
+	for _, i := range s.rows {
+		i.remove(art)
+	}
+	s.objective.remove(art)
+	return success, nil
+}
+
+func anyPivotableSymbol(r *row) symbol {
+	for _, i := range r.symbols() {
+		if i.kind == slackSymbol || i.kind == errorSymbol {
+			return i
+		}
+	}
+	return symbol{}
+}
+
+func (s *simplex) substitute(sym symbol, r *row) {
+	for _, k := range s.rowSymbols() {
+		r2 := s.rows[k]
+		r2.substitute(sym, r)
+		if k.kind != externalSymbol && r2.constant < 0 {
+			s.infeasibleRows = append(s.infeasibleRows, k)
+		}
+	}
+	s.objective.substitute(sym, r)
+	if s.artificial != nil {
+		s.artificial.substitute(sym, r)
+	}
+}
+
+// optimize pivots until the objective is minimized.
+func (s *simplex) optimize(objective *row) error {
+	for {
+		entering := enteringSymbol(objective)
+		if !entering.valid() {
+			return nil
+		}
+		leaving, ok := s.leavingRow(entering)
+		if !ok {
+			return errUnbounded
+		}
+		r := s.rows[leaving]
+		delete(s.rows, leaving)
+		r.solveForPair(leaving, entering)
+		s.substitute(entering, r)
+		s.rows[entering] = r
+	}
+}
+
+// dualOptimize restores feasibility after edit variables change.
+func (s *simplex) dualOptimize() error {
+	for len(s.infeasibleRows) > 0 {
+		leaving := s.infeasibleRows[len(s.infeasibleRows)-1]
+		s.infeasibleRows = s.infeasibleRows[:len(s.infeasibleRows)-1]
+
+		r, ok := s.rows[leaving]
+		if !ok || r.constant >= 0 {
+			continue
+		}
+		entering := s.dualEnteringSymbol(r)
+		if !entering.valid() {
+			return errDualOptimization
+		}
+		delete(s.rows, leaving)
+		r.solveForPair(leaving, entering)
+		s.substitute(entering, r)
+		s.rows[entering] = r
+	}
+	return nil
+}
+
+func enteringSymbol(objective *row) symbol {
+	for _, i := range objective.symbols() {
+		if i.kind != dummySymbol && objective.cells[i] < 0 {
+			return i
+		}
+	}
+	return symbol{}
+}
+
+func (s *simplex) dualEnteringSymbol(r *row) symbol {
+	entering := symbol{}
+	ratio := math.Inf(1)
+	for _, i := range r.symbols() {
+		if c := r.cells[i]; c > 0 && i.kind != dummySymbol {
+			if r2 := s.objective.coefficientFor(i) / c; r2 < ratio {
+				ratio = r2
+				entering = i
+			}
+		}
+	}
+	return entering
+}
+
+func (s *simplex) leavingRow(entering symbol) (symbol, bool) {
+	ratio := math.Inf(1)
+	found := symbol{}
+	for _, k := range s.rowSymbols() {
+		if k.kind == externalSymbol {
+			continue
+		}
+		r := s.rows[k]
+		if c := r.coefficientFor(entering); c < 0 {
+			if r2 := -r.constant / c; r2 < ratio {
+				ratio = r2
+				found = k
+			}
+		}
+	}
+	return found, found.valid()
+}
+
+// markerLeavingRow returns the row to pivot out when removing the constraint with the given marker.
+func (s *simplex) markerLeavingRow(marker symbol) (symbol, bool) {
+	r1, r2 := math.Inf(1), math.Inf(1)
+	var first, second, third symbol
+	for _, k := range s.rowSymbols() {
+		r := s.rows[k]
+		c := r.coefficientFor(marker)
+		if c == 0 {
+			continue
+		}
+		if k.kind == externalSymbol {
+			third = k
+		} else if c < 0 {
+			if ratio := -r.constant / c; ratio < r1 {
+				r1 = ratio
+				first = k
+			}
+		} else {
+			if ratio := r.constant / c; ratio < r2 {
+				r2 = ratio
+				second = k
+			}
+		}
+	}
+	switch {
+	case first.valid():
+		return first, true
+	case second.valid():
+		return second, true
+	default:
+		return third, third.valid()
+	}
+}
+
+func (s *simplex) removeObjectiveEffects(c *simplexConstraint, t tag) {
+	if t.marker.kind == errorSymbol {
+		s.removeMarkerEffects(t.marker, c.strength)
+	}
+	if t.other.kind == errorSymbol {
+		s.removeMarkerEffects(t.other, c.strength)
+	}
+}
+
+func (s *simplex) removeMarkerEffects(marker symbol, strength float64) {
+	if r, ok := s.rows[marker]; ok {
+		s.objective.insertRow(r, -strength)
+	} else {
+		s.objective.insertSymbol(marker, -strength)
+	}
+}
+
+func (s *simplex) rowSymbols() []symbol {
+	syms := make([]symbol, 0, len(s.rows))
+	for k := range s.rows {
+		syms = append(syms, k)
+	}
+	sortSymbols(syms)
+	return syms
+}
+
+func sortSymbols(syms []symbol) {
+	sort.Slice(syms, func(i, j int) bool {
+		return syms[i].id < syms[j].id
+	})
+}
+
+func nearZero(v float64) bool {
+	return math.Abs(v) < 1e-8
+}
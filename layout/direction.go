@@ -0,0 +1,66 @@
+package layout
+
+import "strings"
+
+// Direction is the horizontal direction that content flows in, which depends on the user's language.
+type Direction int
+
+const (
+	// LeftToRight is the direction of languages such as English.
+	LeftToRight Direction = iota
+	// RightToLeft is the direction of languages such as Arabic and Hebrew.
+	RightToLeft
+)
+
+func (d Direction) String() string {
+	switch d {
+	case LeftToRight:
+		return "LeftToRight"
+	case RightToLeft:
+		return "RightToLeft"
+	}
+	return ""
+}
+
+// rtlLanguages are the language subtags that are written right to left.
+var rtlLanguages = map[string]bool{
+	"ar": true, "arc": true, "ckb": true, "dv": true, "fa": true, "he": true, "iw": true, "ji": true,
+	"ks": true, "ps": true, "sd": true, "syr": true, "ug": true, "ur": true, "yi": true,
+}
+
+// rtlScripts are the script subtags that are written right to left.
+var rtlScripts = map[string]bool{
+	"arab": true, "hebr": true, "syrc": true, "thaa": true, "nkoo": true, "adlm": true,
+}
+
+// DirectionForLanguage returns the direction of the BCP 47 language tag, such as "en-US", "he" or "az-Arab".
+func DirectionForLanguage(tag string) Direction {
+	parts := strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(parts) == 0 {
+		return LeftToRight
+	}
+	// An explicit script takes precedence over the language.
+	for _, i := range parts[1:] {
+		if len(i) == 4 {
+			if rtlScripts[i] {
+				return RightToLeft
+			}
+			return LeftToRight
+		}
+	}
+	if rtlLanguages[parts[0]] {
+		return RightToLeft
+	}
+	return LeftToRight
+}
+
+// Mirror returns r flipped horizontally within a container of the given width if d is RightToLeft. Otherwise r is
+// returned unchanged. Layouters can position their children left to right, and then mirror them.
+func (d Direction) Mirror(r Rect, width float64) Rect {
+	if d != RightToLeft {
+		return r
+	}
+	return Rect{Min: Pt(width-r.Max.X, r.Min.Y), Max: Pt(width-r.Min.X, r.Max.Y)}
+}
@@ -0,0 +1,35 @@
+package layout
+
+import "testing"
+
+func TestDirectionForLanguage(t *testing.T) {
+	for _, i := range []struct {
+		tag string
+		d   Direction
+	}{
+		{"", LeftToRight},
+		{"en", LeftToRight},
+		{"en-US", LeftToRight},
+		{"ar", RightToLeft},
+		{"ar_EG", RightToLeft},
+		{"he-IL", RightToLeft},
+		{"FA", RightToLeft},
+		{"az-Arab", RightToLeft},
+		{"az-Latn-AZ", LeftToRight},
+		{"ku-Latn", LeftToRight},
+	} {
+		if d := DirectionForLanguage(i.tag); d != i.d {
+			t.Errorf("DirectionForLanguage(%q) = %v, expected %v", i.tag, d, i.d)
+		}
+	}
+}
+
+func TestDirectionMirror(t *testing.T) {
+	r := Rt(10, 5, 30, 15)
+	if m := LeftToRight.Mirror(r, 100); m != r {
+		t.Errorf("Incorrect frame: %v", m)
+	}
+	if m := RightToLeft.Mirror(r, 100); m != Rt(70, 5, 90, 15) {
+		t.Errorf("Incorrect mirrored frame: %v", m)
+	}
+}
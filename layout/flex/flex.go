@@ -0,0 +1,509 @@
+/*
+Package flex implements a layout system modeled after CSS flexbox.
+
+ l := &flex.Layouter{
+ 	Direction:      flex.Row,
+ 	JustifyContent: flex.JustifySpaceBetween,
+ 	ColumnGap:      8,
+ 	Padding:        layout.In(10, 10, 10, 10),
+ }
+
+ childView := NewChildView(...)
+ l.Add(childView, func(i *flex.Item) {
+ 	i.Grow = 1 // Fill any remaining space along the main axis.
+ 	i.Margin = layout.In(0, 4, 0, 4)
+ })
+ l.Add(NewChildView(...), nil) // Sized by the child's layouter.
+
+ return view.Model{
+ 	Children: l.Views(),
+ 	Layouter: l,
+ }
+
+Children are measured with layout.Context.LayoutChild(). The container prefers to be the size of
+its content, but will grow to the minimum size given by its parent, and shrink to the maximum size.
+Any difference between the content size and the container size is distributed among the children
+according to their Grow and Shrink factors.
+*/
+package flex
+
+import (
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+)
+
+// Direction is the main axis along which children are placed.
+type Direction int
+
+const (
+	// Row places children from left to right. Like CSS, rows are mirrored in a right-to-left layout direction, as is the
+	// cross axis of columns.
+	Row Direction = iota
+	// RowReverse places children from right to left.
+	RowReverse
+	// Column places children from top to bottom.
+	Column
+	// ColumnReverse places children from bottom to top.
+	ColumnReverse
+)
+
+func (d Direction) isRow() bool {
+	return d == Row || d == RowReverse
+}
+
+func (d Direction) isReverse() bool {
+	return d == RowReverse || d == ColumnReverse
+}
+
+// Wrap determines if children may be placed onto multiple lines.
+type Wrap int
+
+const (
+	// NoWrap places all children onto a single line.
+	NoWrap Wrap = iota
+	// WrapForward breaks children onto multiple lines along the cross axis.
+	WrapForward
+	// WrapReverse breaks children onto multiple lines in the opposite direction of the cross axis.
+	WrapReverse
+)
+
+// Justify distributes space between children along the main axis.
+type Justify int
+
+const (
+	// JustifyStart packs children towards the start of the line.
+	JustifyStart Justify = iota
+	// JustifyEnd packs children towards the end of the line.
+	JustifyEnd
+	// JustifyCenter packs children towards the center of the line.
+	JustifyCenter
+	// JustifySpaceBetween evenly distributes children, with the first and last child flush with the edges.
+	JustifySpaceBetween
+	// JustifySpaceAround evenly distributes children, with half-size spaces on either end.
+	JustifySpaceAround
+	// JustifySpaceEvenly evenly distributes children, with equal spaces on either end.
+	JustifySpaceEvenly
+)
+
+// Align positions children, or lines of children, along the cross axis.
+type Align int
+
+const (
+	// AlignAuto defers to the parent. For Item.AlignSelf it uses the Layouter's AlignItems. For
+	// Layouter.AlignItems and Layouter.AlignContent it is the same as AlignStretch.
+	AlignAuto Align = iota
+	// AlignStart places children at the start of the cross axis.
+	AlignStart
+	// AlignEnd places children at the end of the cross axis.
+	AlignEnd
+	// AlignCenter centers children along the cross axis.
+	AlignCenter
+	// AlignStretch stretches children to fill the cross axis.
+	AlignStretch
+)
+
+// Auto can be assigned to Item.Basis to size the item by its content.
+const Auto = -1.0
+
+// Item describes how a single child participates in the layout.
+type Item struct {
+	// Grow is the proportion of the remaining space along the main axis that is given to the child. Defaults to 0.
+	Grow float64
+	// Shrink is the proportion of overflowing space along the main axis that is taken from the child. Defaults to 1.
+	Shrink float64
+	// Basis is the initial size of the child along the main axis. Defaults to Auto.
+	Basis float64
+	// AlignSelf overrides the Layouter's AlignItems for this child. Defaults to AlignAuto.
+	AlignSelf Align
+	// Margin is the space around the child.
+	Margin layout.Insets
+}
+
+// Layouter lays out its children along a main axis, optionally wrapping them onto multiple lines.
+type Layouter struct {
+	Direction      Direction
+	Wrap           Wrap
+	JustifyContent Justify
+	AlignItems     Align
+	AlignContent   Align
+	// RowGap is the space between rows. It is applied between lines for Row and between children for Column.
+	RowGap float64
+	// ColumnGap is the space between columns. It is applied between children for Row and between lines for Column.
+	ColumnGap float64
+	Padding   layout.Insets
+
+	items []*Item
+	views []view.View
+}
+
+// Add adds v to the layouter. If f is non-nil, it is immediately called to configure the child's Item.
+func (l *Layouter) Add(v view.View, f func(*Item)) {
+	i := &Item{Shrink: 1, Basis: Auto}
+	if f != nil {
+		f(i)
+	}
+	l.items = append(l.items, i)
+	l.views = append(l.views, v)
+}
+
+// Views returns all views that have been added to l.
+func (l *Layouter) Views() []view.View {
+	return l.views
+}
+
+// axes converts between x/y coordinates and main/cross coordinates.
+type axes struct {
+	row bool
+}
+
+func (a axes) main(p layout.Point) float64 {
+	if a.row {
+		return p.X
+	}
+	return p.Y
+}
+
+func (a axes) cross(p layout.Point) float64 {
+	if a.row {
+		return p.Y
+	}
+	return p.X
+}
+
+func (a axes) point(main, cross float64) layout.Point {
+	if a.row {
+		return layout.Pt(main, cross)
+	}
+	return layout.Pt(cross, main)
+}
+
+// insets returns the leading and trailing insets along the main and cross axes.
+func (a axes) insets(i layout.Insets) (mainStart, mainEnd, crossStart, crossEnd float64) {
+	if a.row {
+		return i.Left, i.Right, i.Top, i.Bottom
+	}
+	return i.Top, i.Bottom, i.Left, i.Right
+}
+
+type flexItem struct {
+	idx        int
+	item       *Item
+	base       float64 // flex base size
+	main       float64 // resolved main size
+	cross      float64 // resolved cross size
+	mainStart  float64 // main-axis margins
+	mainEnd    float64
+	crossStart float64 // cross-axis margins
+	crossEnd   float64
+	frozen     bool
+	pos        float64 // main-axis offset within the line
+	crossPos   float64 // cross-axis offset within the line
+}
+
+func (i *flexItem) outerMain() float64 {
+	return i.main + i.mainStart + i.mainEnd
+}
+
+func (i *flexItem) outerBase() float64 {
+	return i.base + i.mainStart + i.mainEnd
+}
+
+func (i *flexItem) outerCross() float64 {
+	return i.cross + i.crossStart + i.crossEnd
+}
+
+type flexLine struct {
+	items []*flexItem
+	cross float64
+	pos   float64
+}
+
+// Layout implements the view.Layouter interface.
+func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	ax := axes{row: l.Direction.isRow()}
+	padMainStart, padMainEnd, padCrossStart, padCrossEnd := ax.insets(l.Padding)
+	padMain := padMainStart + padMainEnd
+	padCross := padCrossStart + padCrossEnd
+
+	mainGap, crossGap := l.ColumnGap, l.RowGap
+	if !ax.row {
+		mainGap, crossGap = l.RowGap, l.ColumnGap
+	}
+
+	minMain := math.Max(ax.main(ctx.MinSize)-padMain, 0)
+	maxMain := math.Max(ax.main(ctx.MaxSize)-padMain, 0)
+	minCross := math.Max(ax.cross(ctx.MinSize)-padCross, 0)
+	maxCross := math.Max(ax.cross(ctx.MaxSize)-padCross, 0)
+
+	// Determine the flex base size of each child.
+	items := make([]*flexItem, 0, len(l.items))
+	for idx, i := range l.items {
+		fi := &flexItem{idx: idx, item: i}
+		fi.mainStart, fi.mainEnd, fi.crossStart, fi.crossEnd = ax.insets(i.Margin)
+		if i.Basis >= 0 {
+			fi.base = i.Basis
+		} else {
+			// Like CSS, the content size is measured without constraining the main axis.
+			availCross := math.Max(maxCross-fi.crossStart-fi.crossEnd, 0)
+			g := ctx.LayoutChild(idx, layout.Pt(0, 0), ax.point(math.Inf(1), availCross))
+			fi.base = ax.main(layout.Pt(g.Width(), g.Height()))
+		}
+		fi.main = fi.base
+		items = append(items, fi)
+	}
+
+	// Collect children into lines.
+	lines := []*flexLine{}
+	line := &flexLine{}
+	lineMain := 0.0
+	for _, i := range items {
+		size := i.outerBase()
+		if len(line.items) > 0 {
+			size += mainGap
+		}
+		if l.Wrap != NoWrap && len(line.items) > 0 && lineMain+size > maxMain {
+			lines = append(lines, line)
+			line = &flexLine{}
+			lineMain = 0
+			size = i.outerBase()
+		}
+		line.items = append(line.items, i)
+		lineMain += size
+	}
+	if len(line.items) > 0 || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+
+	// The container is sized to its longest line, within the bounds given by the parent.
+	contentMain := 0.0
+	for _, i := range lines {
+		contentMain = math.Max(contentMain, lineBaseSize(i, mainGap))
+	}
+	innerMain := math.Min(math.Max(contentMain, minMain), maxMain)
+
+	// Resolve the flexible lengths and the cross size of each child.
+	for _, i := range lines {
+		resolveFlexibleLengths(i, innerMain, mainGap)
+		for _, j := range i.items {
+			availCross := math.Max(maxCross-j.crossStart-j.crossEnd, 0)
+			g := ctx.LayoutChild(j.idx, ax.point(j.main, 0), ax.point(j.main, availCross))
+			j.cross = ax.cross(layout.Pt(g.Width(), g.Height()))
+			i.cross = math.Max(i.cross, j.outerCross())
+		}
+	}
+
+	// Determine the cross size of the container and its lines.
+	contentCross := 0.0
+	for idx, i := range lines {
+		if idx > 0 {
+			contentCross += crossGap
+		}
+		contentCross += i.cross
+	}
+	innerCross := math.Min(math.Max(contentCross, minCross), maxCross)
+	if len(lines) == 1 && l.Wrap == NoWrap {
+		lines[0].cross = innerCross
+	}
+
+	// Distribute any remaining cross space between lines.
+	free := innerCross - contentCross
+	crossOffset := 0.0
+	if len(lines) > 1 || l.Wrap != NoWrap {
+		switch l.AlignContent {
+		case AlignEnd:
+			crossOffset = free
+		case AlignCenter:
+			crossOffset = free / 2
+		case AlignAuto, AlignStretch:
+			if free > 0 {
+				for _, i := range lines {
+					i.cross += free / float64(len(lines))
+				}
+			}
+		}
+	}
+	for _, i := range lines {
+		i.pos = crossOffset
+		crossOffset += i.cross + crossGap
+	}
+
+	// Align children within each line.
+	for _, i := range lines {
+		justify(i, innerMain, mainGap, l.JustifyContent)
+
+		for _, j := range i.items {
+			align := j.item.AlignSelf
+			if align == AlignAuto {
+				align = l.AlignItems
+			}
+			switch align {
+			case AlignStart:
+				j.crossPos = j.crossStart
+			case AlignEnd:
+				j.crossPos = i.cross - j.cross - j.crossEnd
+			case AlignCenter:
+				j.crossPos = j.crossStart + (i.cross-j.outerCross())/2
+			case AlignAuto, AlignStretch:
+				j.crossPos = j.crossStart
+				stretched := math.Max(i.cross-j.crossStart-j.crossEnd, 0)
+				if stretched != j.cross {
+					p := ax.point(j.main, stretched)
+					g := ctx.LayoutChild(j.idx, p, p)
+					j.cross = ax.cross(layout.Pt(g.Width(), g.Height()))
+				}
+			}
+		}
+	}
+
+	// Convert main and cross positions into frames.
+	gs := make([]layout.Guide, len(items))
+	for _, i := range lines {
+		for _, j := range i.items {
+			main := j.pos
+			if l.Direction.isReverse() {
+				main = innerMain - j.pos - j.main
+			}
+			cross := i.pos + j.crossPos
+			if l.Wrap == WrapReverse {
+				cross = innerCross - cross - j.cross
+			}
+			origin := ax.point(padMainStart+main, padCrossStart+cross)
+			size := ax.point(j.main, j.cross)
+			gs[j.idx] = layout.Guide{
+				Frame:  layout.Rt(origin.X, origin.Y, origin.X+size.X, origin.Y+size.Y),
+				ZIndex: j.idx,
+			}
+		}
+	}
+
+	size := ax.point(innerMain+padMain, innerCross+padCross)
+	g := layout.Guide{Frame: layout.Rt(0, 0, size.X, size.Y)}
+	for i := range gs {
+		gs[i].Frame = ctx.Direction.Mirror(gs[i].Frame, size.X)
+	}
+	return g, gs
+}
+
+// lineBaseSize returns the outer size of the line using the flex base sizes.
+func lineBaseSize(l *flexLine, gap float64) float64 {
+	size := 0.0
+	for idx, i := range l.items {
+		if idx > 0 {
+			size += gap
+		}
+		size += i.outerBase()
+	}
+	return size
+}
+
+// resolveFlexibleLengths grows or shrinks the items in l to fill size.
+func resolveFlexibleLengths(l *flexLine, size, gap float64) {
+	used := lineBaseSize(l, gap)
+	growing := used < size
+	for _, i := range l.items {
+		i.main = i.base
+		i.frozen = (growing && i.item.Grow <= 0) || (!growing && i.item.Shrink <= 0) || used == size
+	}
+
+	for {
+		// Calculate the remaining free space, using resolved sizes for frozen items and base sizes for the others.
+		free := size
+		factors := 0.0
+		for idx, i := range l.items {
+			if idx > 0 {
+				free -= gap
+			}
+			if i.frozen {
+				free -= i.outerMain()
+				continue
+			}
+			free -= i.outerBase()
+			if growing {
+				factors += i.item.Grow
+			} else {
+				factors += i.item.Shrink * i.base
+			}
+		}
+		if factors == 0 {
+			return
+		}
+
+		// Distribute the free space, freezing any item that would become negative.
+		violation := false
+		for _, i := range l.items {
+			if i.frozen {
+				continue
+			}
+			if growing {
+				i.main = i.base + free*i.item.Grow/factors
+			} else {
+				i.main = i.base + free*i.item.Shrink*i.base/factors
+			}
+			if i.main < 0 {
+				i.main = 0
+				i.frozen = true
+				violation = true
+			}
+		}
+		if !violation {
+			return
+		}
+	}
+}
+
+// justify positions the items in l along the main axis.
+func justify(l *flexLine, size, gap float64, j Justify) {
+	used := 0.0
+	for idx, i := range l.items {
+		if idx > 0 {
+			used += gap
+		}
+		used += i.outerMain()
+	}
+	free := size - used
+	n := float64(len(l.items))
+
+	offset, spacing := 0.0, 0.0
+	switch j {
+	case JustifyEnd:
+		offset = free
+	case JustifyCenter:
+		offset = free / 2
+	case JustifySpaceBetween:
+		if free > 0 && n > 1 {
+			spacing = free / (n - 1)
+		}
+	case JustifySpaceAround:
+		if free > 0 {
+			spacing = free / n
+			offset = spacing / 2
+		} else {
+			offset = free / 2
+		}
+	case JustifySpaceEvenly:
+		if free > 0 {
+			spacing = free / (n + 1)
+			offset = spacing
+		} else {
+			offset = free / 2
+		}
+	}
+
+	for _, i := range l.items {
+		i.pos = offset + i.mainStart
+		offset += i.outerMain() + gap + spacing
+	}
+}
+
+// Notify implements the view.Layouter interface.
+func (l *Layouter) Notify(f func()) comm.Id {
+	return 0 // no-op
+}
+
+// Unnotify implements the view.Layouter interface.
+func (l *Layouter) Unnotify(id comm.Id) {
+	// no-op
+}
@@ -0,0 +1,333 @@
+package flex
+
+import (
+	"math"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+// child is a fake view with a fixed intrinsic size.
+type child struct {
+	size layout.Point
+	item func(*Item)
+}
+
+func layoutCase(l *Layouter, children []child, min, max layout.Point, d layout.Direction) (layout.Guide, []layout.Guide) {
+	for _, i := range children {
+		l.Add(nil, i.item)
+	}
+	ctx := &layout.Context{
+		MinSize:    min,
+		MaxSize:    max,
+		ChildCount: len(children),
+		Direction:  d,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			s := children[idx].size
+			s.X = math.Min(math.Max(s.X, min.X), max.X)
+			s.Y = math.Min(math.Max(s.Y, min.Y), max.Y)
+			return layout.Guide{Frame: layout.Rt(0, 0, s.X, s.Y)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+func sized(w, h float64) child {
+	return child{size: layout.Pt(w, h)}
+}
+
+func grow(w, h, g float64) child {
+	return child{size: layout.Pt(w, h), item: func(i *Item) { i.Grow = g }}
+}
+
+var inf = math.Inf(1)
+
+func TestLayout(t *testing.T) {
+	cases := []struct {
+		name      string
+		layouter  *Layouter
+		children  []child
+		min, max  layout.Point
+		direction layout.Direction
+		frame     layout.Rect
+		frames    []layout.Rect
+	}{
+		{
+			name:     "row sized to content",
+			layouter: &Layouter{AlignItems: AlignStart},
+			children: []child{sized(10, 20), sized(30, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 40, 20),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 20), layout.Rt(10, 0, 40, 10)},
+		},
+		{
+			name:     "row stretches by default",
+			layouter: &Layouter{},
+			children: []child{sized(10, 20), sized(30, 10)},
+			min:      layout.Pt(0, 50),
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 40, 50),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 50), layout.Rt(10, 0, 40, 50)},
+		},
+		{
+			name:     "column",
+			layouter: &Layouter{Direction: Column, AlignItems: AlignStart},
+			children: []child{sized(10, 20), sized(30, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 30, 30),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 20), layout.Rt(0, 20, 30, 30)},
+		},
+		{
+			name:      "row right to left",
+			layouter:  &Layouter{AlignItems: AlignStart},
+			children:  []child{sized(10, 20), sized(30, 10)},
+			max:       layout.Pt(inf, inf),
+			direction: layout.RightToLeft,
+			frame:     layout.Rt(0, 0, 40, 20),
+			frames:    []layout.Rect{layout.Rt(30, 0, 40, 20), layout.Rt(0, 0, 30, 10)},
+		},
+		{
+			name:      "column right to left",
+			layouter:  &Layouter{Direction: Column, AlignItems: AlignStart},
+			children:  []child{sized(10, 20), sized(30, 10)},
+			max:       layout.Pt(inf, inf),
+			direction: layout.RightToLeft,
+			frame:     layout.Rt(0, 0, 30, 30),
+			frames:    []layout.Rect{layout.Rt(20, 0, 30, 20), layout.Rt(0, 20, 30, 30)},
+		},
+		{
+			name:     "row reverse",
+			layouter: &Layouter{Direction: RowReverse, AlignItems: AlignStart},
+			children: []child{sized(10, 10), sized(20, 10)},
+			min:      layout.Pt(100, 10),
+			max:      layout.Pt(100, 10),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(90, 0, 100, 10), layout.Rt(70, 0, 90, 10)},
+		},
+		{
+			name:     "column reverse",
+			layouter: &Layouter{Direction: ColumnReverse, AlignItems: AlignStart},
+			children: []child{sized(10, 10), sized(10, 20)},
+			min:      layout.Pt(10, 100),
+			max:      layout.Pt(10, 100),
+			frame:    layout.Rt(0, 0, 10, 100),
+			frames:   []layout.Rect{layout.Rt(0, 90, 10, 100), layout.Rt(0, 70, 10, 90)},
+		},
+		{
+			name:     "justify end",
+			layouter: &Layouter{JustifyContent: JustifyEnd},
+			children: []child{sized(10, 10), sized(20, 10)},
+			min:      layout.Pt(100, 10),
+			max:      layout.Pt(100, 10),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(70, 0, 80, 10), layout.Rt(80, 0, 100, 10)},
+		},
+		{
+			name:     "justify center",
+			layouter: &Layouter{JustifyContent: JustifyCenter},
+			children: []child{sized(10, 10), sized(20, 10)},
+			min:      layout.Pt(100, 10),
+			max:      layout.Pt(100, 10),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(35, 0, 45, 10), layout.Rt(45, 0, 65, 10)},
+		},
+		{
+			name:     "justify space between",
+			layouter: &Layouter{JustifyContent: JustifySpaceBetween},
+			children: []child{sized(10, 10), sized(10, 10), sized(10, 10)},
+			min:      layout.Pt(100, 10),
+			max:      layout.Pt(100, 10),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 10), layout.Rt(45, 0, 55, 10), layout.Rt(90, 0, 100, 10)},
+		},
+		{
+			name:     "justify space around",
+			layouter: &Layouter{JustifyContent: JustifySpaceAround},
+			children: []child{sized(10, 10), sized(10, 10)},
+			min:      layout.Pt(100, 10),
+			max:      layout.Pt(100, 10),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(20, 0, 30, 10), layout.Rt(70, 0, 80, 10)},
+		},
+		{
+			name:     "justify space evenly",
+			layouter: &Layouter{JustifyContent: JustifySpaceEvenly},
+			children: []child{sized(10, 10), sized(10, 10)},
+			min:      layout.Pt(80, 10),
+			max:      layout.Pt(80, 10),
+			frame:    layout.Rt(0, 0, 80, 10),
+			frames:   []layout.Rect{layout.Rt(20, 0, 30, 10), layout.Rt(50, 0, 60, 10)},
+		},
+		{
+			name:     "align items",
+			layouter: &Layouter{AlignItems: AlignCenter},
+			children: []child{sized(10, 10), {size: layout.Pt(10, 10), item: func(i *Item) { i.AlignSelf = AlignEnd }}, {size: layout.Pt(10, 10), item: func(i *Item) { i.AlignSelf = AlignStart }}},
+			min:      layout.Pt(30, 50),
+			max:      layout.Pt(30, 50),
+			frame:    layout.Rt(0, 0, 30, 50),
+			frames:   []layout.Rect{layout.Rt(0, 20, 10, 30), layout.Rt(10, 40, 20, 50), layout.Rt(20, 0, 30, 10)},
+		},
+		{
+			name:     "grow",
+			layouter: &Layouter{},
+			children: []child{grow(10, 10, 1), grow(10, 10, 3), sized(20, 10)},
+			min:      layout.Pt(120, 10),
+			max:      layout.Pt(120, 10),
+			frame:    layout.Rt(0, 0, 120, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(30, 0, 100, 10), layout.Rt(100, 0, 120, 10)},
+		},
+		{
+			name:     "grow with zero basis",
+			layouter: &Layouter{},
+			children: []child{
+				{size: layout.Pt(50, 10), item: func(i *Item) { i.Grow, i.Basis = 1, 0 }},
+				{size: layout.Pt(10, 10), item: func(i *Item) { i.Grow, i.Basis = 1, 0 }},
+			},
+			min:    layout.Pt(100, 10),
+			max:    layout.Pt(100, 10),
+			frame:  layout.Rt(0, 0, 100, 10),
+			frames: []layout.Rect{layout.Rt(0, 0, 50, 10), layout.Rt(50, 0, 100, 10)},
+		},
+		{
+			name:     "shrink weighted by basis",
+			layouter: &Layouter{},
+			children: []child{sized(100, 10), sized(50, 10)},
+			min:      layout.Pt(0, 10),
+			max:      layout.Pt(120, 10),
+			frame:    layout.Rt(0, 0, 120, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 80, 10), layout.Rt(80, 0, 120, 10)},
+		},
+		{
+			name:     "no shrink",
+			layouter: &Layouter{},
+			children: []child{{size: layout.Pt(100, 10), item: func(i *Item) { i.Shrink = 0 }}, sized(50, 10)},
+			min:      layout.Pt(0, 10),
+			max:      layout.Pt(120, 10),
+			frame:    layout.Rt(0, 0, 120, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 100, 10), layout.Rt(100, 0, 120, 10)},
+		},
+		{
+			name:     "shrink clamps at zero",
+			layouter: &Layouter{},
+			children: []child{
+				{size: layout.Pt(10, 10), item: func(i *Item) { i.Shrink = 10 }},
+				sized(100, 10),
+			},
+			min:    layout.Pt(0, 10),
+			max:    layout.Pt(50, 10),
+			frame:  layout.Rt(0, 0, 50, 10),
+			frames: []layout.Rect{layout.Rt(0, 0, 0, 10), layout.Rt(0, 0, 50, 10)},
+		},
+		{
+			name:     "gap",
+			layouter: &Layouter{ColumnGap: 5},
+			children: []child{sized(10, 10), sized(10, 10), sized(10, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 40, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 10), layout.Rt(15, 0, 25, 10), layout.Rt(30, 0, 40, 10)},
+		},
+		{
+			name:     "padding",
+			layouter: &Layouter{Padding: layout.In(1, 2, 3, 4)},
+			children: []child{sized(10, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 16, 14),
+			frames:   []layout.Rect{layout.Rt(2, 1, 12, 11)},
+		},
+		{
+			name:     "margin",
+			layouter: &Layouter{AlignItems: AlignStart},
+			children: []child{
+				{size: layout.Pt(10, 10), item: func(i *Item) { i.Margin = layout.In(1, 2, 3, 4) }},
+				sized(10, 10),
+			},
+			max:    layout.Pt(inf, inf),
+			frame:  layout.Rt(0, 0, 26, 14),
+			frames: []layout.Rect{layout.Rt(2, 1, 12, 11), layout.Rt(16, 0, 26, 10)},
+		},
+		{
+			name:     "stretch with margin",
+			layouter: &Layouter{},
+			children: []child{{size: layout.Pt(10, 10), item: func(i *Item) { i.Margin = layout.In(5, 0, 5, 0) }}},
+			min:      layout.Pt(10, 40),
+			max:      layout.Pt(10, 40),
+			frame:    layout.Rt(0, 0, 10, 40),
+			frames:   []layout.Rect{layout.Rt(0, 5, 10, 35)},
+		},
+		{
+			name:     "wrap",
+			layouter: &Layouter{Wrap: WrapForward, AlignItems: AlignStart},
+			children: []child{sized(40, 10), sized(40, 20), sized(40, 10)},
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 80, 30),
+			frames:   []layout.Rect{layout.Rt(0, 0, 40, 10), layout.Rt(40, 0, 80, 20), layout.Rt(0, 20, 40, 30)},
+		},
+		{
+			name:     "wrap with gaps",
+			layouter: &Layouter{Wrap: WrapForward, AlignItems: AlignStart, RowGap: 5, ColumnGap: 10},
+			children: []child{sized(40, 10), sized(40, 10), sized(40, 10)},
+			max:      layout.Pt(95, inf),
+			frame:    layout.Rt(0, 0, 90, 25),
+			frames:   []layout.Rect{layout.Rt(0, 0, 40, 10), layout.Rt(50, 0, 90, 10), layout.Rt(0, 15, 40, 25)},
+		},
+		{
+			name:     "wrap reverse",
+			layouter: &Layouter{Wrap: WrapReverse, AlignItems: AlignStart},
+			children: []child{sized(40, 10), sized(40, 10), sized(40, 10)},
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 20),
+			frames:   []layout.Rect{layout.Rt(0, 10, 40, 20), layout.Rt(40, 10, 80, 20), layout.Rt(0, 0, 40, 10)},
+		},
+		{
+			name:     "wrap grows per line",
+			layouter: &Layouter{Wrap: WrapForward},
+			children: []child{grow(40, 10, 1), grow(40, 10, 1), grow(40, 10, 1)},
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 20),
+			frames:   []layout.Rect{layout.Rt(0, 0, 50, 10), layout.Rt(50, 0, 100, 10), layout.Rt(0, 10, 100, 20)},
+		},
+		{
+			name:     "align content stretch",
+			layouter: &Layouter{Wrap: WrapForward},
+			children: []child{sized(60, 10), sized(60, 10)},
+			min:      layout.Pt(100, 40),
+			max:      layout.Pt(100, 40),
+			frame:    layout.Rt(0, 0, 100, 40),
+			frames:   []layout.Rect{layout.Rt(0, 0, 60, 20), layout.Rt(0, 20, 60, 40)},
+		},
+		{
+			name:     "align content center",
+			layouter: &Layouter{Wrap: WrapForward, AlignContent: AlignCenter},
+			children: []child{sized(60, 10), sized(60, 10)},
+			min:      layout.Pt(100, 40),
+			max:      layout.Pt(100, 40),
+			frame:    layout.Rt(0, 0, 100, 40),
+			frames:   []layout.Rect{layout.Rt(0, 10, 60, 20), layout.Rt(0, 20, 60, 30)},
+		},
+		{
+			name:     "empty",
+			layouter: &Layouter{Padding: layout.In(5, 5, 5, 5)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 10, 10),
+			frames:   []layout.Rect{},
+		},
+	}
+
+	for _, c := range cases {
+		g, gs := layoutCase(c.layouter, c.children, c.min, c.max, c.direction)
+		if g.Frame != c.frame {
+			t.Errorf("%v: frame %v, expected %v", c.name, g.Frame, c.frame)
+		}
+		if len(gs) != len(c.frames) {
+			t.Errorf("%v: %v child guides, expected %v", c.name, len(gs), len(c.frames))
+			continue
+		}
+		for idx, i := range gs {
+			if i.Frame != c.frames[idx] {
+				t.Errorf("%v: child %v frame %v, expected %v", c.name, idx, i.Frame, c.frames[idx])
+			}
+		}
+	}
+}
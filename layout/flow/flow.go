@@ -0,0 +1,258 @@
+/*
+Package flow implements a layout system that places children in rows, wrapping them onto new lines when they run out
+of space, like words in a paragraph. It is useful for tags and chips.
+
+ l := &flow.Layouter{
+ 	Spacing:     8,
+ 	LineSpacing: 8,
+ 	MaxLines:    2,
+ 	OnOverflow: func(hidden int) {
+ 		if v.hidden != hidden {
+ 			v.hidden = hidden // Show a "+n" chip in the next build.
+ 			v.Signal()
+ 		}
+ 	},
+ }
+ for _, i := range tags {
+ 	l.Add(NewChipView(i))
+ }
+
+ return view.Model{
+ 	Children: l.Views(),
+ 	Layouter: l,
+ }
+
+Children are measured with layout.Context.LayoutChild(), and may be as wide as the container. The container is as
+wide as its longest line, within the bounds given by its parent, and as tall as its lines. Lines run from the leading
+edge, so they are mirrored in a right-to-left layout direction.
+
+A parent may lay out the container several times at different sizes while measuring it, so OnOverflow is only called
+after the layout phase, with the number of children hidden by the final layout.
+*/
+package flow
+
+import (
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+)
+
+// Justify distributes space between children along a line.
+type Justify int
+
+const (
+	// JustifyStart packs children towards the leading edge of the line.
+	JustifyStart Justify = iota
+	// JustifyEnd packs children towards the trailing edge of the line.
+	JustifyEnd
+	// JustifyCenter packs children towards the center of the line.
+	JustifyCenter
+	// JustifySpaceBetween distributes space evenly between children. The last line is packed towards the leading edge.
+	JustifySpaceBetween
+)
+
+// Align positions children vertically within a line.
+type Align int
+
+const (
+	// AlignStart aligns children with the top of the line.
+	AlignStart Align = iota
+	// AlignEnd aligns children with the bottom of the line.
+	AlignEnd
+	// AlignCenter centers children within the line.
+	AlignCenter
+	// AlignStretch stretches children to the height of the line.
+	AlignStretch
+)
+
+// Layouter places its children in lines, wrapping them when a line runs out of space.
+type Layouter struct {
+	// Spacing is the space between consecutive children on a line.
+	Spacing float64
+	// LineSpacing is the space between lines.
+	LineSpacing float64
+	// Insets are the space around the lines. In a right-to-left layout direction, Left and Right are the leading and
+	// trailing insets.
+	Insets     layout.Insets
+	Justify    Justify
+	AlignItems Align
+	// MaxLines is the maximum number of lines. Children that do not fit are given an empty frame. If MaxLines is 0,
+	// the number of lines is unlimited.
+	MaxLines int
+	// OnOverflow is called after the layout phase with the number of children that do not fit within MaxLines, if it
+	// has changed since the previous call.
+	OnOverflow func(hidden int)
+
+	views    []view.View
+	overflow int
+	// overflows is the number of hidden children for each size that l has been laid out with since the final layout
+	// of the previous layout phase.
+	overflows map[flowKey]int
+	reported  bool
+	// reportedOverflow is the value that OnOverflow was last called with.
+	reportedOverflow int
+}
+
+type flowKey struct {
+	minSize, maxSize layout.Point
+}
+
+// Add adds v to the layouter.
+func (l *Layouter) Add(v view.View) {
+	l.views = append(l.views, v)
+}
+
+// Views returns all views that have been added to l.
+func (l *Layouter) Views() []view.View {
+	return l.views
+}
+
+// Overflow returns the number of children that did not fit within MaxLines. After the layout phase, it reflects the
+// final layout. During the layout phase, it reflects the most recent layout, which may have been a measuring pass.
+func (l *Layouter) Overflow() int {
+	return l.overflow
+}
+
+type flowLine struct {
+	start, end int // range of children on the line
+	width      float64
+	height     float64
+	pos        float64
+}
+
+// Layout implements the view.Layouter interface.
+func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	padX := l.Insets.Left + l.Insets.Right
+	padY := l.Insets.Top + l.Insets.Bottom
+	maxWidth := math.Max(ctx.MaxSize.X-padX, 0)
+	maxHeight := math.Max(ctx.MaxSize.Y-padY, 0)
+
+	// Measure the children and collect them into lines.
+	gs := make([]layout.Guide, ctx.ChildCount)
+	lines := []*flowLine{}
+	line := &flowLine{}
+	for i := 0; i < ctx.ChildCount; i++ {
+		g := ctx.LayoutChild(i, layout.Pt(0, 0), layout.Pt(maxWidth, maxHeight))
+		g.ZIndex = i
+		gs[i] = g
+
+		width := g.Width()
+		if i > line.start {
+			width += l.Spacing
+		}
+		if i > line.start && line.width+width > maxWidth {
+			lines = append(lines, line)
+			line = &flowLine{start: i}
+			width = g.Width()
+		}
+		line.end = i + 1
+		line.width += width
+		line.height = math.Max(line.height, g.Height())
+	}
+	if line.end > line.start {
+		lines = append(lines, line)
+	}
+
+	// Hide the children on lines past MaxLines.
+	hidden := 0
+	if l.MaxLines > 0 && len(lines) > l.MaxLines {
+		lines = lines[:l.MaxLines]
+		last := lines[len(lines)-1].end
+		for i := last; i < len(gs); i++ {
+			gs[i] = layout.Guide{ZIndex: i}
+		}
+		hidden = len(gs) - last
+	}
+	l.overflow = hidden
+	if l.overflows == nil {
+		l.overflows = map[flowKey]int{}
+	}
+	l.overflows[flowKey{minSize: ctx.MinSize, maxSize: ctx.MaxSize}] = hidden
+
+	// The container is sized to its content, within the bounds given by the parent.
+	contentWidth, contentHeight := 0.0, 0.0
+	for idx, i := range lines {
+		if idx > 0 {
+			contentHeight += l.LineSpacing
+		}
+		i.pos = contentHeight
+		contentHeight += i.height
+		contentWidth = math.Max(contentWidth, i.width)
+	}
+	width := math.Min(math.Max(contentWidth+padX, ctx.MinSize.X), ctx.MaxSize.X)
+	height := math.Min(math.Max(contentHeight+padY, ctx.MinSize.Y), ctx.MaxSize.Y)
+	innerWidth := math.Max(width-padX, 0)
+
+	// Position the children within each line.
+	for idx, i := range lines {
+		offset, spacing := 0.0, l.Spacing
+		free := innerWidth - i.width
+		switch l.Justify {
+		case JustifyEnd:
+			offset = free
+		case JustifyCenter:
+			offset = free / 2
+		case JustifySpaceBetween:
+			if count := i.end - i.start; count > 1 && idx < len(lines)-1 && free > 0 {
+				spacing += free / float64(count-1)
+			}
+		}
+
+		x := l.Insets.Left + offset
+		for j := i.start; j < i.end; j++ {
+			g := gs[j]
+			w, h := g.Width(), g.Height()
+			y := l.Insets.Top + i.pos
+			switch l.AlignItems {
+			case AlignEnd:
+				y += i.height - h
+			case AlignCenter:
+				y += (i.height - h) / 2
+			case AlignStretch:
+				if h != i.height {
+					g = ctx.LayoutChild(j, layout.Pt(w, i.height), layout.Pt(w, i.height))
+					g.ZIndex = j
+					w, h = g.Width(), g.Height()
+				}
+			}
+			g.Frame = ctx.Direction.Mirror(layout.Rt(x, y, x+w, y+h), width)
+			gs[j] = g
+			x += w + spacing
+		}
+	}
+
+	return layout.Guide{Frame: layout.Rt(0, 0, width, height)}, gs
+}
+
+// FinishLayout implements the layout.Finisher interface.
+func (l *Layouter) FinishLayout(minSize, maxSize layout.Point) {
+	key := flowKey{minSize: minSize, maxSize: maxSize}
+	hidden, ok := l.overflows[key]
+	if !ok {
+		return
+	}
+	// The final layout may be restored from the cache of the view in a later layout phase.
+	l.overflows = map[flowKey]int{key: hidden}
+	l.overflow = hidden
+
+	if l.reported && hidden == l.reportedOverflow {
+		return
+	}
+	l.reported = true
+	l.reportedOverflow = hidden
+	if l.OnOverflow != nil {
+		l.OnOverflow(hidden)
+	}
+}
+
+// Notify implements the view.Layouter interface.
+func (l *Layouter) Notify(f func()) comm.Id {
+	return 0 // no-op
+}
+
+// Unnotify implements the view.Layouter interface.
+func (l *Layouter) Unnotify(id comm.Id) {
+	// no-op
+}
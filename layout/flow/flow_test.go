@@ -0,0 +1,249 @@
+package flow
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/basicview"
+)
+
+func layoutCase(l *Layouter, sizes []layout.Point, min, max layout.Point, d layout.Direction) (layout.Guide, []layout.Guide) {
+	for range sizes {
+		l.Add(nil)
+	}
+	ctx := &layout.Context{
+		MinSize:    min,
+		MaxSize:    max,
+		ChildCount: len(sizes),
+		Direction:  d,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			s := sizes[idx]
+			s.X = math.Min(math.Max(s.X, min.X), max.X)
+			s.Y = math.Min(math.Max(s.Y, min.Y), max.Y)
+			return layout.Guide{Frame: layout.Rt(0, 0, s.X, s.Y)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+var inf = math.Inf(1)
+
+func TestLayout(t *testing.T) {
+	chips := []layout.Point{layout.Pt(30, 10), layout.Pt(40, 20), layout.Pt(20, 10), layout.Pt(50, 10)}
+	cases := []struct {
+		name      string
+		layouter  *Layouter
+		sizes     []layout.Point
+		min, max  layout.Point
+		direction layout.Direction
+		frame     layout.Rect
+		frames    []layout.Rect
+	}{
+		{
+			name:     "single line",
+			layouter: &Layouter{Spacing: 5},
+			sizes:    chips,
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 155, 20),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(35, 0, 75, 20), layout.Rt(80, 0, 100, 10), layout.Rt(105, 0, 155, 10)},
+		},
+		{
+			name:     "wrap",
+			layouter: &Layouter{Spacing: 5, LineSpacing: 2},
+			sizes:    chips,
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 32),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(35, 0, 75, 20), layout.Rt(80, 0, 100, 10), layout.Rt(0, 22, 50, 32)},
+		},
+		{
+			name:     "insets",
+			layouter: &Layouter{Spacing: 5, Insets: layout.In(1, 2, 3, 4)},
+			sizes:    chips[:3],
+			max:      layout.Pt(81, inf),
+			frame:    layout.Rt(0, 0, 81, 34),
+			frames:   []layout.Rect{layout.Rt(2, 1, 32, 11), layout.Rt(37, 1, 77, 21), layout.Rt(2, 21, 22, 31)},
+		},
+		{
+			name:     "justify end and align center",
+			layouter: &Layouter{Spacing: 5, Justify: JustifyEnd, AlignItems: AlignCenter},
+			sizes:    chips[:2],
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 20),
+			frames:   []layout.Rect{layout.Rt(25, 5, 55, 15), layout.Rt(60, 0, 100, 20)},
+		},
+		{
+			name:     "justify center",
+			layouter: &Layouter{Spacing: 5, Justify: JustifyCenter},
+			sizes:    chips[:2],
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 20),
+			frames:   []layout.Rect{layout.Rt(12.5, 0, 42.5, 10), layout.Rt(47.5, 0, 87.5, 20)},
+		},
+		{
+			name:     "justify space between",
+			layouter: &Layouter{Spacing: 5, Justify: JustifySpaceBetween, AlignItems: AlignStretch},
+			sizes:    []layout.Point{chips[0], chips[1], chips[3]},
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 30),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 20), layout.Rt(60, 0, 100, 20), layout.Rt(0, 20, 50, 30)},
+		},
+		{
+			name:     "max lines",
+			layouter: &Layouter{Spacing: 5, MaxLines: 1},
+			sizes:    chips,
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 20),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(35, 0, 75, 20), layout.Rt(80, 0, 100, 10), {}},
+		},
+		{
+			name:      "right to left",
+			layouter:  &Layouter{Spacing: 5},
+			sizes:     chips,
+			max:       layout.Pt(100, inf),
+			direction: layout.RightToLeft,
+			frame:     layout.Rt(0, 0, 100, 30),
+			frames:    []layout.Rect{layout.Rt(70, 0, 100, 10), layout.Rt(25, 0, 65, 20), layout.Rt(0, 0, 20, 10), layout.Rt(50, 20, 100, 30)},
+		},
+		{
+			name:     "empty",
+			layouter: &Layouter{Insets: layout.In(5, 5, 5, 5)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 10, 10),
+			frames:   []layout.Rect{},
+		},
+	}
+
+	for _, c := range cases {
+		g, gs := layoutCase(c.layouter, c.sizes, c.min, c.max, c.direction)
+		if g.Frame != c.frame {
+			t.Errorf("%v: frame %v, expected %v", c.name, g.Frame, c.frame)
+		}
+		if len(gs) != len(c.frames) {
+			t.Errorf("%v: %v child guides, expected %v", c.name, len(gs), len(c.frames))
+			continue
+		}
+		for idx, i := range gs {
+			if i.Frame != c.frames[idx] {
+				t.Errorf("%v: child %v frame %v, expected %v", c.name, idx, i.Frame, c.frames[idx])
+			}
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	l := &Layouter{MaxLines: 2}
+	sizes := []layout.Point{layout.Pt(60, 10), layout.Pt(60, 10), layout.Pt(60, 10), layout.Pt(60, 10)}
+	g, _ := layoutCase(l, sizes, layout.Pt(0, 0), layout.Pt(100, inf), layout.LeftToRight)
+	if l.Overflow() != 2 {
+		t.Errorf("Incorrect overflow: %v", l.Overflow())
+	}
+	if g.Frame != layout.Rt(0, 0, 60, 20) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+}
+
+func TestOverflowRelayout(t *testing.T) {
+	sizes := []layout.Point{layout.Pt(60, 10), layout.Pt(60, 10), layout.Pt(60, 10), layout.Pt(60, 10)}
+	for _, i := range []struct {
+		max      layout.Point
+		overflow int
+	}{
+		{layout.Pt(100, inf), 2},
+		{layout.Pt(200, inf), 0},
+		{layout.Pt(50, inf), 2},
+	} {
+		// Reuse the layouter, as a parent that measures it at several sizes does.
+		l := &Layouter{MaxLines: 2}
+		layoutCase(l, sizes, layout.Pt(0, 0), layout.Pt(130, inf), layout.LeftToRight)
+		layoutCase(l, sizes, layout.Pt(0, 0), i.max, layout.LeftToRight)
+		if l.Overflow() != i.overflow {
+			t.Errorf("%v: Incorrect overflow: %v", i.max, l.Overflow())
+		}
+	}
+}
+
+type sizeLayouter struct {
+	comm.Relay
+	size layout.Point
+}
+
+func (l *sizeLayouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	return layout.Guide{Frame: layout.Rt(0, 0, l.size.X, l.size.Y)}, nil
+}
+
+// measureLayouter lays out its child at each of widths, and uses the last one.
+type measureLayouter struct {
+	comm.Relay
+	widths []float64
+}
+
+func (l *measureLayouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	var g layout.Guide
+	for _, i := range l.widths {
+		g = ctx.LayoutChild(0, layout.Pt(0, 0), layout.Pt(i, inf))
+	}
+	return layout.Guide{Frame: layout.Rt(0, 0, ctx.MinSize.X, ctx.MinSize.Y)}, []layout.Guide{g}
+}
+
+type measureView struct {
+	view.Embed
+	layouter *measureLayouter
+	child    view.View
+}
+
+func (v *measureView) Build(ctx *view.Context) view.Model {
+	return view.Model{Children: []view.View{v.child}, Layouter: v.layouter}
+}
+
+type chipsView struct {
+	view.Embed
+	onOverflow func(int)
+}
+
+func (v *chipsView) Build(ctx *view.Context) view.Model {
+	l := &Layouter{MaxLines: 1, OnOverflow: v.onOverflow}
+	for i := 0; i < 4; i++ {
+		chip := basicview.New()
+		chip.Layouter = &sizeLayouter{size: layout.Pt(60, 10)}
+		l.Add(chip)
+	}
+	return view.Model{Children: l.Views(), Layouter: l}
+}
+
+func TestOnOverflow(t *testing.T) {
+	calls := []int{}
+	parent := &measureView{
+		layouter: &measureLayouter{widths: []float64{50, 200}},
+		child:    &chipsView{onOverflow: func(hidden int) { calls = append(calls, hidden) }},
+	}
+	r := view.NewRoot(parent)
+	defer r.Stop()
+
+	// The measuring pass at 50 hides 3 children, but only the final layout at 200 is reported.
+	r.SetSize(layout.Pt(300, 300))
+	r.Snapshot()
+	if !reflect.DeepEqual(calls, []int{1}) {
+		t.Errorf("Incorrect overflow calls: %v", calls)
+	}
+
+	parent.layouter.widths = []float64{200, 130}
+	r.SetSize(layout.Pt(300, 300))
+	r.Snapshot()
+	if !reflect.DeepEqual(calls, []int{1, 2}) {
+		t.Errorf("Incorrect overflow calls: %v", calls)
+	}
+
+	// Laying out again with the same sizes does not report an unchanged overflow.
+	r.SetSize(layout.Pt(300, 300))
+	r.Snapshot()
+	if !reflect.DeepEqual(calls, []int{1, 2}) {
+		t.Errorf("Incorrect overflow calls: %v", calls)
+	}
+}
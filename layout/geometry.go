@@ -2,8 +2,10 @@ package layout
 
 import (
 	"fmt"
+	"math"
 
 	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/internal/device"
 	pblayout "gomatcha.io/matcha/pb/layout"
 )
 
@@ -42,6 +44,163 @@ func (r Rect) Add(p Point) Rect {
 	return n
 }
 
+// Sub translates rect r by -p.
+func (r Rect) Sub(p Point) Rect {
+	return r.Add(Pt(-p.X, -p.Y))
+}
+
+// Width returns the width of r.
+func (r Rect) Width() float64 {
+	return r.Max.X - r.Min.X
+}
+
+// Height returns the height of r.
+func (r Rect) Height() float64 {
+	return r.Max.Y - r.Min.Y
+}
+
+// Size returns the width and height of r.
+func (r Rect) Size() Size {
+	return Size{Width: r.Width(), Height: r.Height()}
+}
+
+// Center returns the center point of r.
+func (r Rect) Center() Point {
+	return Pt((r.Min.X+r.Max.X)/2, (r.Min.Y+r.Max.Y)/2)
+}
+
+// IsEmpty returns true if r has no area.
+func (r Rect) IsEmpty() bool {
+	return r.Min.X >= r.Max.X || r.Min.Y >= r.Max.Y
+}
+
+// Canon returns the canonical version of r, where Min is to the top left of Max.
+func (r Rect) Canon() Rect {
+	if r.Max.X < r.Min.X {
+		r.Min.X, r.Max.X = r.Max.X, r.Min.X
+	}
+	if r.Max.Y < r.Min.Y {
+		r.Min.Y, r.Max.Y = r.Max.Y, r.Min.Y
+	}
+	return r
+}
+
+// Inset returns r shrunk by i. If i is larger than r, the result is an empty rectangle at the center of the insets.
+func (r Rect) Inset(i Insets) Rect {
+	n := Rt(r.Min.X+i.Left, r.Min.Y+i.Top, r.Max.X-i.Right, r.Max.Y-i.Bottom)
+	if n.Min.X > n.Max.X {
+		n.Min.X = (n.Min.X + n.Max.X) / 2
+		n.Max.X = n.Min.X
+	}
+	if n.Min.Y > n.Max.Y {
+		n.Min.Y = (n.Min.Y + n.Max.Y) / 2
+		n.Max.Y = n.Min.Y
+	}
+	return n
+}
+
+// Outset returns r grown by i.
+func (r Rect) Outset(i Insets) Rect {
+	return Rt(r.Min.X-i.Left, r.Min.Y-i.Top, r.Max.X+i.Right, r.Max.Y+i.Bottom)
+}
+
+// Intersect returns the largest rectangle contained by both r and s. If they do not overlap, the zero Rect is
+// returned.
+func (r Rect) Intersect(s Rect) Rect {
+	n := Rt(math.Max(r.Min.X, s.Min.X), math.Max(r.Min.Y, s.Min.Y), math.Min(r.Max.X, s.Max.X), math.Min(r.Max.Y, s.Max.Y))
+	if n.IsEmpty() {
+		return Rect{}
+	}
+	return n
+}
+
+// Union returns the smallest rectangle that contains both r and s. Empty rectangles are ignored.
+func (r Rect) Union(s Rect) Rect {
+	if r.IsEmpty() {
+		return s
+	}
+	if s.IsEmpty() {
+		return r
+	}
+	return Rt(math.Min(r.Min.X, s.Min.X), math.Min(r.Min.Y, s.Min.Y), math.Max(r.Max.X, s.Max.X), math.Max(r.Max.Y, s.Max.Y))
+}
+
+// Overlaps returns true if r and s have a non-empty intersection.
+func (r Rect) Overlaps(s Rect) bool {
+	return !r.Intersect(s).IsEmpty()
+}
+
+// Contains returns true if p is inside of r. Points on the top and left edges are inside, and points on the bottom
+// and right edges are outside.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.Min.X && p.X < r.Max.X && p.Y >= r.Min.Y && p.Y < r.Max.Y
+}
+
+// ContainsRect returns true if s is entirely inside of r.
+func (r Rect) ContainsRect(s Rect) bool {
+	return s.Min.X >= r.Min.X && s.Max.X <= r.Max.X && s.Min.Y >= r.Min.Y && s.Max.Y <= r.Max.Y
+}
+
+// Split divides r into two rectangles. slice is the part of r within distance of the single edge e, and remainder is
+// the rest. distance is clamped to the size of r.
+//
+//	header, body := frame.Split(44, layout.EdgeTop)
+func (r Rect) Split(distance float64, e Edge) (slice, remainder Rect) {
+	slice, remainder = r, r
+	switch e {
+	case EdgeTop:
+		y := math.Min(r.Min.Y+math.Max(distance, 0), r.Max.Y)
+		slice.Max.Y, remainder.Min.Y = y, y
+	case EdgeLeft:
+		x := math.Min(r.Min.X+math.Max(distance, 0), r.Max.X)
+		slice.Max.X, remainder.Min.X = x, x
+	case EdgeBottom:
+		y := math.Max(r.Max.Y-math.Max(distance, 0), r.Min.Y)
+		slice.Min.Y, remainder.Max.Y = y, y
+	case EdgeRight:
+		x := math.Max(r.Max.X-math.Max(distance, 0), r.Min.X)
+		slice.Min.X, remainder.Max.X = x, x
+	}
+	return slice, remainder
+}
+
+// AspectFit returns the largest rectangle with the aspect ratio of s that fits inside of r, centered in r.
+func (r Rect) AspectFit(s Size) Rect {
+	scale := math.Min(r.Width()/s.Width, r.Height()/s.Height)
+	return r.aspect(s, scale)
+}
+
+// AspectFill returns the smallest rectangle with the aspect ratio of s that covers r, centered in r.
+func (r Rect) AspectFill(s Size) Rect {
+	scale := math.Max(r.Width()/s.Width, r.Height()/s.Height)
+	return r.aspect(s, scale)
+}
+
+func (r Rect) aspect(s Size, scale float64) Rect {
+	if s.IsEmpty() || math.IsNaN(scale) || math.IsInf(scale, 0) {
+		c := r.Center()
+		return Rect{Min: c, Max: c}
+	}
+	c := r.Center()
+	w, h := s.Width*scale, s.Height*scale
+	return Rt(c.X-w/2, c.Y-h/2, c.X+w/2, c.Y+h/2)
+}
+
+// Lerp returns the rect between r and s at ratio t, where 0 is r and 1 is s.
+func (r Rect) Lerp(s Rect, t float64) Rect {
+	return Rect{Min: r.Min.Lerp(s.Min, t), Max: r.Max.Lerp(s.Max, t)}
+}
+
+// Snap returns r with its edges rounded to the nearest pixel on the device's screen.
+func (r Rect) Snap() Rect {
+	return r.SnapToScale(device.ScreenScale)
+}
+
+// SnapToScale returns r with its edges rounded to the nearest multiple of 1/scale.
+func (r Rect) SnapToScale(scale float64) Rect {
+	return Rect{Min: r.Min.SnapToScale(scale), Max: r.Max.SnapToScale(scale)}
+}
+
 // String returns a string description of r.
 func (r Rect) String() string {
 	return fmt.Sprintf("Rect{%v, %v, %v, %v}", r.Min.X, r.Min.Y, r.Max.X, r.Max.Y)
@@ -58,6 +217,117 @@ func Pt(x, y float64) Point {
 	return Point{X: x, Y: y}
 }
 
+// Add returns the vector p+q.
+func (p Point) Add(q Point) Point {
+	return Pt(p.X+q.X, p.Y+q.Y)
+}
+
+// Sub returns the vector p-q.
+func (p Point) Sub(q Point) Point {
+	return Pt(p.X-q.X, p.Y-q.Y)
+}
+
+// Mul returns the vector p*k.
+func (p Point) Mul(k float64) Point {
+	return Pt(p.X*k, p.Y*k)
+}
+
+// Div returns the vector p/k.
+func (p Point) Div(k float64) Point {
+	return Pt(p.X/k, p.Y/k)
+}
+
+// Dot returns the dot product of p and q.
+func (p Point) Dot(q Point) float64 {
+	return p.X*q.X + p.Y*q.Y
+}
+
+// Length returns the distance of p from the origin.
+func (p Point) Length() float64 {
+	return math.Hypot(p.X, p.Y)
+}
+
+// Distance returns the distance between p and q.
+func (p Point) Distance(q Point) float64 {
+	return p.Sub(q).Length()
+}
+
+// Normalize returns the unit vector in the direction of p. The zero Point is returned unchanged.
+func (p Point) Normalize() Point {
+	l := p.Length()
+	if l == 0 {
+		return p
+	}
+	return p.Div(l)
+}
+
+// Lerp returns the point between p and q at ratio r, where 0 is p and 1 is q.
+func (p Point) Lerp(q Point, r float64) Point {
+	return Pt(p.X+(q.X-p.X)*r, p.Y+(q.Y-p.Y)*r)
+}
+
+// Snap returns p rounded to the nearest pixel on the device's screen.
+func (p Point) Snap() Point {
+	return p.SnapToScale(device.ScreenScale)
+}
+
+// SnapToScale returns p rounded to the nearest multiple of 1/scale.
+func (p Point) SnapToScale(scale float64) Point {
+	if scale <= 0 {
+		return p
+	}
+	return Pt(math.Floor(p.X*scale+0.5)/scale, math.Floor(p.Y*scale+0.5)/scale)
+}
+
+// String returns a string description of p.
+func (p Point) String() string {
+	return fmt.Sprintf("Point{%v, %v}", p.X, p.Y)
+}
+
+// Size represents the width and height of a rectangle.
+type Size struct {
+	Width  float64
+	Height float64
+}
+
+// Sz creates a size with width and height.
+func Sz(width, height float64) Size {
+	return Size{Width: width, Height: height}
+}
+
+// Point returns s as a Point, as used by Context.MinSize and Context.MaxSize.
+func (s Size) Point() Point {
+	return Pt(s.Width, s.Height)
+}
+
+// Rect returns a rectangle of size s at the origin.
+func (s Size) Rect() Rect {
+	return Rt(0, 0, s.Width, s.Height)
+}
+
+// IsEmpty returns true if s has no area.
+func (s Size) IsEmpty() bool {
+	return s.Width <= 0 || s.Height <= 0
+}
+
+// Scale returns s scaled by k.
+func (s Size) Scale(k float64) Size {
+	return Size{Width: s.Width * k, Height: s.Height * k}
+}
+
+// Clamp returns s constrained to be at least min and at most max in each dimension.
+func (s Size) Clamp(min, max Size) Size {
+	return Size{
+		Width:  math.Min(math.Max(s.Width, min.Width), max.Width),
+		Height: math.Min(math.Max(s.Height, min.Height), max.Height),
+	}
+}
+
+// String returns a string description of s.
+func (s Size) String() string {
+	return fmt.Sprintf("Size{%v, %v}", s.Width, s.Height)
+}
+
 // MarshalProtobuf serializes p into a protobuf object.
 func (p *Point) MarshalProtobuf() *pblayout.Point {
 	return &pblayout.Point{
@@ -72,8 +342,96 @@ func (p *Point) UnmarshalProtobuf(pbpoint *pblayout.Point) {
 	p.Y = pbpoint.Y
 }
 
+// Insets represents the distance inward from each edge of a rectangle.
+type Insets struct {
+	Top    float64
+	Left   float64
+	Bottom float64
+	Right  float64
+}
+
+// In creates insets with top, left, bottom and right.
+func In(top, left, bottom, right float64) Insets {
+	return Insets{Top: top, Left: left, Bottom: bottom, Right: right}
+}
+
+// InEdges creates insets of v on the edges e, and 0 on the other edges.
+//
+//	layout.InEdges(layout.EdgeLeft|layout.EdgeRight, 8)
+func InEdges(e Edge, v float64) Insets {
+	i := Insets{}
+	if e&EdgeTop != 0 {
+		i.Top = v
+	}
+	if e&EdgeLeft != 0 {
+		i.Left = v
+	}
+	if e&EdgeBottom != 0 {
+		i.Bottom = v
+	}
+	if e&EdgeRight != 0 {
+		i.Right = v
+	}
+	return i
+}
+
+// Horizontal returns the sum of the left and right insets.
+func (i Insets) Horizontal() float64 {
+	return i.Left + i.Right
+}
+
+// Vertical returns the sum of the top and bottom insets.
+func (i Insets) Vertical() float64 {
+	return i.Top + i.Bottom
+}
+
+// Add returns the sum of i and j on each edge.
+func (i Insets) Add(j Insets) Insets {
+	return Insets{Top: i.Top + j.Top, Left: i.Left + j.Left, Bottom: i.Bottom + j.Bottom, Right: i.Right + j.Right}
+}
+
+// Edge is a set of edges of a rectangle.
+type Edge int
+
+const (
+	EdgeTop Edge = 1 << iota
+	EdgeLeft
+	EdgeBottom
+	EdgeRight
+	// EdgeHorizontal is the left and right edges.
+	EdgeHorizontal = EdgeLeft | EdgeRight
+	// EdgeVertical is the top and bottom edges.
+	EdgeVertical = EdgeTop | EdgeBottom
+	// EdgeAll is every edge.
+	EdgeAll = EdgeHorizontal | EdgeVertical
+)
+
+// MarshalProtobuf serializes i into a protobuf object.
+func (i *Insets) MarshalProtobuf() *pblayout.Insets {
+	return &pblayout.Insets{
+		Top:    i.Top,
+		Left:   i.Left,
+		Bottom: i.Bottom,
+		Right:  i.Right,
+	}
+}
+
+// UnmarshalProtobuf deserializes i from a protobuf object.
+func (i *Insets) UnmarshalProtobuf(pbinsets *pblayout.Insets) {
+	i.Top = pbinsets.Top
+	i.Left = pbinsets.Left
+	i.Bottom = pbinsets.Bottom
+	i.Right = pbinsets.Right
+}
+
 // PointNotifier wraps the comm.Notifier interface with an additional Value() method which returns a Point.
 type PointNotifier interface {
 	comm.Notifier
 	Value() Point
 }
+
+// RectNotifier wraps the comm.Notifier interface with an additional Value() method which returns a Rect.
+type RectNotifier interface {
+	comm.Notifier
+	Value() Rect
+}
@@ -0,0 +1,133 @@
+package layout
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRect(t *testing.T) {
+	r := Rt(10, 20, 50, 40)
+	if r.Width() != 40 || r.Height() != 20 || r.Size() != Sz(40, 20) || r.Center() != Pt(30, 30) {
+		t.Errorf("Incorrect size: %v %v", r.Size(), r.Center())
+	}
+	if r.IsEmpty() || !Rt(10, 10, 10, 20).IsEmpty() {
+		t.Errorf("Incorrect IsEmpty")
+	}
+	if c := Rt(50, 40, 10, 20).Canon(); c != r {
+		t.Errorf("Incorrect Canon: %v", c)
+	}
+	if i := r.Inset(In(1, 2, 3, 4)); i != Rt(12, 21, 46, 37) {
+		t.Errorf("Incorrect Inset: %v", i)
+	}
+	if i := r.Inset(InEdges(EdgeAll, 30)); i != Rt(30, 30, 30, 30) {
+		t.Errorf("Incorrect Inset: %v", i)
+	}
+	if o := r.Outset(InEdges(EdgeHorizontal, 5)); o != Rt(5, 20, 55, 40) {
+		t.Errorf("Incorrect Outset: %v", o)
+	}
+	if i := r.Intersect(Rt(0, 30, 20, 100)); i != Rt(10, 30, 20, 40) {
+		t.Errorf("Incorrect Intersect: %v", i)
+	}
+	if i := r.Intersect(Rt(60, 0, 70, 10)); i != (Rect{}) || r.Overlaps(Rt(50, 20, 60, 40)) {
+		t.Errorf("Incorrect Intersect: %v", i)
+	}
+	if u := r.Union(Rt(0, 30, 20, 100)); u != Rt(0, 20, 50, 100) {
+		t.Errorf("Incorrect Union: %v", u)
+	}
+	if u := (Rect{}).Union(r); u != r {
+		t.Errorf("Incorrect Union: %v", u)
+	}
+	if !r.Contains(Pt(10, 20)) || r.Contains(Pt(50, 30)) || r.Contains(Pt(0, 0)) {
+		t.Errorf("Incorrect Contains")
+	}
+	if !r.ContainsRect(Rt(10, 20, 30, 40)) || r.ContainsRect(Rt(0, 20, 30, 40)) {
+		t.Errorf("Incorrect ContainsRect")
+	}
+	if r.Sub(Pt(10, 20)) != Rt(0, 0, 40, 20) {
+		t.Errorf("Incorrect Sub: %v", r.Sub(Pt(10, 20)))
+	}
+	if l := r.Lerp(Rt(20, 0, 70, 40), 0.5); l != Rt(15, 10, 60, 40) {
+		t.Errorf("Incorrect Lerp: %v", l)
+	}
+}
+
+func TestRectSplit(t *testing.T) {
+	r := Rt(0, 0, 100, 50)
+	for _, i := range []struct {
+		distance         float64
+		edge             Edge
+		slice, remainder Rect
+	}{
+		{10, EdgeTop, Rt(0, 0, 100, 10), Rt(0, 10, 100, 50)},
+		{10, EdgeLeft, Rt(0, 0, 10, 50), Rt(10, 0, 100, 50)},
+		{10, EdgeBottom, Rt(0, 40, 100, 50), Rt(0, 0, 100, 40)},
+		{10, EdgeRight, Rt(90, 0, 100, 50), Rt(0, 0, 90, 50)},
+		{80, EdgeTop, Rt(0, 0, 100, 50), Rt(0, 50, 100, 50)},
+	} {
+		slice, remainder := r.Split(i.distance, i.edge)
+		if slice != i.slice || remainder != i.remainder {
+			t.Errorf("Split(%v, %v) = %v, %v", i.distance, i.edge, slice, remainder)
+		}
+	}
+}
+
+func TestRectAspect(t *testing.T) {
+	r := Rt(0, 0, 100, 50)
+	if f := r.AspectFit(Sz(20, 20)); f != Rt(25, 0, 75, 50) {
+		t.Errorf("Incorrect AspectFit: %v", f)
+	}
+	if f := r.AspectFill(Sz(20, 20)); f != Rt(0, -25, 100, 75) {
+		t.Errorf("Incorrect AspectFill: %v", f)
+	}
+	if f := r.AspectFit(Sz(0, 10)); f != Rt(50, 25, 50, 25) {
+		t.Errorf("Incorrect AspectFit: %v", f)
+	}
+}
+
+func TestPoint(t *testing.T) {
+	p, q := Pt(3, 4), Pt(1, 2)
+	if p.Add(q) != Pt(4, 6) || p.Sub(q) != Pt(2, 2) || p.Mul(2) != Pt(6, 8) || p.Div(2) != Pt(1.5, 2) {
+		t.Errorf("Incorrect arithmetic")
+	}
+	if p.Dot(q) != 11 || p.Length() != 5 || p.Distance(Pt(0, 0)) != 5 {
+		t.Errorf("Incorrect length")
+	}
+	if n := p.Normalize(); math.Abs(n.Length()-1) > 1e-9 || (Point{}).Normalize() != (Point{}) {
+		t.Errorf("Incorrect Normalize: %v", n)
+	}
+	if l := q.Lerp(p, 0.5); l != Pt(2, 3) {
+		t.Errorf("Incorrect Lerp: %v", l)
+	}
+}
+
+func TestSnap(t *testing.T) {
+	if p := Pt(1.2, 1.3).SnapToScale(2); p != Pt(1, 1.5) {
+		t.Errorf("Incorrect SnapToScale: %v", p)
+	}
+	if r := Rt(0.2, 0.6, 10.4, 10.76).SnapToScale(3); r != Rt(1.0/3, 2.0/3, 31.0/3, 32.0/3) {
+		t.Errorf("Incorrect SnapToScale: %v", r)
+	}
+	if r := Rt(0.4, 0.6, 1, 2).Snap(); r != Rt(0, 1, 1, 2) {
+		t.Errorf("Incorrect Snap: %v", r)
+	}
+}
+
+func TestSize(t *testing.T) {
+	s := Sz(10, 20)
+	if s.Point() != Pt(10, 20) || s.Rect() != Rt(0, 0, 10, 20) || s.Scale(2) != Sz(20, 40) {
+		t.Errorf("Incorrect conversion")
+	}
+	if c := s.Clamp(Sz(15, 0), Sz(100, 15)); c != Sz(15, 15) {
+		t.Errorf("Incorrect Clamp: %v", c)
+	}
+	if s.IsEmpty() || !Sz(0, 10).IsEmpty() {
+		t.Errorf("Incorrect IsEmpty")
+	}
+}
+
+func TestGuideCenter(t *testing.T) {
+	g := Guide{Frame: Rt(10, 20, 30, 60)}
+	if g.CenterX() != 20 || g.CenterY() != 40 {
+		t.Errorf("Incorrect center: %v %v", g.CenterX(), g.CenterY())
+	}
+}
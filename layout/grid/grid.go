@@ -0,0 +1,455 @@
+/*
+Package grid implements a two dimensional layout system of rows and columns, similar to CSS grid.
+
+ l := &grid.Layouter{
+ 	Columns:   []grid.Track{grid.Fixed(80), grid.Fraction(1), grid.Fraction(2)},
+ 	AutoRows:  grid.Flexible(44, math.Inf(1)),
+ 	ColumnGap: 8,
+ 	RowGap:    8,
+ }
+
+ l.Add(header, func(c *grid.Cell) {
+ 	c.ColumnSpan = 3 // The header spans the full width of the grid.
+ })
+ for _, i := range items {
+ 	l.Add(i, nil) // Children are placed into the next free cell.
+ }
+
+ return view.Model{
+ 	Children: l.Views(),
+ 	Layouter: l,
+ }
+
+Fixed and Flexible tracks are sized before Fraction tracks. Any space remaining in the grid
+is then divided between the Fraction tracks in proportion to their fractions. Like the
+other layouters, the grid prefers to be the size of its content, but will grow to the
+minimum size given by its parent, and shrink to the maximum size.
+*/
+package grid
+
+import (
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+)
+
+type trackKind int
+
+const (
+	autoTrack trackKind = iota
+	flexibleTrack
+	fractionTrack
+)
+
+// Track describes the sizing of a single row or column. The zero value is a track that is sized to its content.
+type Track struct {
+	kind     trackKind
+	min      float64
+	max      float64
+	fraction float64
+}
+
+// Fixed returns a track that is always v points.
+func Fixed(v float64) Track {
+	return Track{kind: flexibleTrack, min: v, max: v}
+}
+
+// Flexible returns a track that is sized to fit its content, within min and max.
+func Flexible(min, max float64) Track {
+	return Track{kind: flexibleTrack, min: min, max: max}
+}
+
+// Fraction returns a track that takes a fraction f of the space that remains after all other tracks have been sized.
+func Fraction(f float64) Track {
+	return Track{kind: fractionTrack, fraction: f}
+}
+
+func (t Track) clamp(v float64) float64 {
+	if t.kind == autoTrack {
+		return math.Max(v, 0)
+	}
+	return math.Min(math.Max(v, t.min), t.max)
+}
+
+// Align positions a child within its cell.
+type Align int
+
+const (
+	// AlignAuto defers to the parent. For Cell it uses the Layouter's alignment. For Layouter it is the same as AlignStretch.
+	AlignAuto Align = iota
+	// AlignStart places the child at the top or left edge of the cell.
+	AlignStart
+	// AlignEnd places the child at the bottom or right edge of the cell.
+	AlignEnd
+	// AlignCenter centers the child within the cell.
+	AlignCenter
+	// AlignStretch stretches the child to fill the cell.
+	AlignStretch
+)
+
+// Auto can be assigned to Cell.Row or Cell.Column to automatically place the child in the next free cell.
+const Auto = -1
+
+// Cell describes the position of a child in the grid.
+type Cell struct {
+	// Row is the index of the first row the child occupies. Defaults to Auto.
+	Row int
+	// Column is the index of the first column the child occupies. Defaults to Auto.
+	Column int
+	// RowSpan is the number of rows the child occupies. Defaults to 1.
+	RowSpan int
+	// ColumnSpan is the number of columns the child occupies. Defaults to 1.
+	ColumnSpan int
+	// JustifySelf overrides the Layouter's JustifyItems for this child.
+	JustifySelf Align
+	// AlignSelf overrides the Layouter's AlignItems for this child.
+	AlignSelf Align
+}
+
+// Layouter positions its children into a grid of rows and columns.
+type Layouter struct {
+	// Columns is the list of column tracks. If empty, the grid has a single column sized to its content. Columns are
+	// placed from right to left in a right-to-left layout direction.
+	Columns []Track
+	// Rows is the list of explicit row tracks.
+	Rows []Track
+	// AutoRows is the track used for any rows beyond those in Rows.
+	AutoRows Track
+	// RowGap is the space between rows.
+	RowGap float64
+	// ColumnGap is the space between columns.
+	ColumnGap float64
+	// JustifyItems is the horizontal alignment of children within their cells.
+	JustifyItems Align
+	// AlignItems is the vertical alignment of children within their cells.
+	AlignItems Align
+	Padding    layout.Insets
+
+	cells []*Cell
+	views []view.View
+}
+
+// Add adds v to the layouter. If f is non-nil, it is immediately called to configure the child's Cell.
+func (l *Layouter) Add(v view.View, f func(*Cell)) {
+	c := &Cell{Row: Auto, Column: Auto, RowSpan: 1, ColumnSpan: 1}
+	if f != nil {
+		f(c)
+	}
+	if c.RowSpan < 1 {
+		c.RowSpan = 1
+	}
+	if c.ColumnSpan < 1 {
+		c.ColumnSpan = 1
+	}
+	l.cells = append(l.cells, c)
+	l.views = append(l.views, v)
+}
+
+// Views returns all views that have been added to l.
+func (l *Layouter) Views() []view.View {
+	return l.views
+}
+
+// area is the resolved position of a child in the grid.
+type area struct {
+	row, column, rowSpan, columnSpan int
+}
+
+// place resolves the position of every cell, automatically placing cells without an explicit
+// row or column into the next free cell in row-major order.
+func (l *Layouter) place(columnCount int) ([]area, int) {
+	occupied := map[[2]int]bool{}
+	occupy := func(a area) {
+		for r := a.row; r < a.row+a.rowSpan; r++ {
+			for c := a.column; c < a.column+a.columnSpan; c++ {
+				occupied[[2]int{r, c}] = true
+			}
+		}
+	}
+	isFree := func(a area, bounded bool) bool {
+		// Children wider than the grid may only be placed in the first column.
+		if bounded && a.column != 0 && a.column+a.columnSpan > columnCount {
+			return false
+		}
+		for r := a.row; r < a.row+a.rowSpan; r++ {
+			for c := a.column; c < a.column+a.columnSpan; c++ {
+				if occupied[[2]int{r, c}] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	areas := make([]area, len(l.cells))
+
+	// Place the explicitly positioned cells first.
+	for idx, i := range l.cells {
+		if i.Row >= 0 && i.Column >= 0 {
+			areas[idx] = area{row: i.Row, column: i.Column, rowSpan: i.RowSpan, columnSpan: i.ColumnSpan}
+			occupy(areas[idx])
+		}
+	}
+
+	// Then place the remaining cells using a cursor.
+	row, column := 0, 0
+	for idx, i := range l.cells {
+		if i.Row >= 0 && i.Column >= 0 {
+			continue
+		}
+		a := area{rowSpan: i.RowSpan, columnSpan: i.ColumnSpan}
+		switch {
+		case i.Row >= 0:
+			// Fixed row, find the first free column.
+			a.row = i.Row
+			for a.column = 0; !isFree(a, false); a.column++ {
+			}
+		case i.Column >= 0:
+			// Fixed column, find the first free row after the cursor.
+			a.column = i.Column
+			for a.row = row; !isFree(a, false); a.row++ {
+			}
+		default:
+			a.row, a.column = row, column
+			for !isFree(a, true) {
+				a.column++
+				if a.column >= columnCount {
+					a.column = 0
+					a.row++
+				}
+			}
+			row, column = a.row, a.column+a.columnSpan
+			if column >= columnCount {
+				row, column = row+1, 0
+			}
+		}
+		areas[idx] = a
+		occupy(a)
+	}
+
+	rowCount := len(l.Rows)
+	for _, i := range areas {
+		if i.row+i.rowSpan > rowCount {
+			rowCount = i.row + i.rowSpan
+		}
+	}
+	return areas, rowCount
+}
+
+// sizeTracks resolves the size of each track. measure returns the content size of the child
+// at idx along the axis.
+func sizeTracks(tracks []Track, spans [][2]int, measure func(idx int) float64, gap, min, max float64) []float64 {
+	sizes := make([]float64, len(tracks))
+	fractions := 0.0
+	for idx, i := range tracks {
+		if i.kind == fractionTrack {
+			fractions += i.fraction
+		} else {
+			sizes[idx] = i.clamp(0)
+		}
+	}
+
+	// Size content based tracks, starting with children that only span a single track.
+	content := make([]float64, len(spans))
+	for idx, i := range spans {
+		if i[1] != 1 {
+			continue
+		}
+		content[idx] = measure(idx)
+		t := tracks[i[0]]
+		if t.kind == fractionTrack {
+			continue
+		}
+		sizes[i[0]] = math.Max(sizes[i[0]], t.clamp(content[idx]))
+	}
+
+	// Children spanning multiple tracks distribute any extra size among their non-fractional tracks.
+	for idx, i := range spans {
+		if i[1] == 1 {
+			continue
+		}
+		content[idx] = measure(idx)
+		current := gap * float64(i[1]-1)
+		growable := []int{}
+		for j := i[0]; j < i[0]+i[1]; j++ {
+			current += sizes[j]
+			if t := tracks[j]; t.kind != fractionTrack && t.clamp(math.Inf(1)) > sizes[j] {
+				growable = append(growable, j)
+			}
+		}
+		if extra := content[idx] - current; extra > 0 && len(growable) > 0 {
+			for _, j := range growable {
+				sizes[j] = tracks[j].clamp(sizes[j] + extra/float64(len(growable)))
+			}
+		}
+	}
+
+	// Determine the content size of the grid. Fractional tracks are sized so that each
+	// fraction is large enough to fit its content.
+	total := gap * math.Max(float64(len(tracks)-1), 0)
+	for _, i := range sizes {
+		total += i
+	}
+	fractionSize := 0.0
+	for idx, i := range spans {
+		if i[1] == 1 && tracks[i[0]].kind == fractionTrack && tracks[i[0]].fraction > 0 {
+			fractionSize = math.Max(fractionSize, content[idx]/tracks[i[0]].fraction)
+		}
+	}
+	contentTotal := total + fractionSize*fractions
+
+	// Divide the remaining space among fractional tracks.
+	if fractions > 0 {
+		target := math.Min(math.Max(contentTotal, min), max)
+		remaining := math.Max(target-total, 0)
+		for idx, i := range tracks {
+			if i.kind == fractionTrack {
+				sizes[idx] = remaining * i.fraction / fractions
+			}
+		}
+	}
+	return sizes
+}
+
+// offsets returns the start position of each track.
+func offsets(sizes []float64, start, gap float64) []float64 {
+	o := make([]float64, len(sizes)+1)
+	o[0] = start
+	for idx, i := range sizes {
+		o[idx+1] = o[idx] + i + gap
+	}
+	return o
+}
+
+// spanSize returns the size of count tracks starting at start, including the gaps between them.
+func spanSize(sizes []float64, start, count int, gap float64) float64 {
+	s := gap * float64(count-1)
+	for i := start; i < start+count; i++ {
+		s += sizes[i]
+	}
+	return s
+}
+
+func total(sizes []float64, gap float64) float64 {
+	if len(sizes) == 0 {
+		return 0
+	}
+	return spanSize(sizes, 0, len(sizes), gap)
+}
+
+// Layout implements the view.Layouter interface.
+func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	columns := l.Columns
+	if len(columns) == 0 {
+		columns = []Track{{}}
+	}
+	areas, rowCount := l.place(len(columns))
+
+	// Grow the column tracks if a child was explicitly placed outside of them.
+	for _, i := range areas {
+		for len(columns) < i.column+i.columnSpan {
+			columns = append(columns, Track{})
+		}
+	}
+	rows := make([]Track, rowCount)
+	for idx := range rows {
+		if idx < len(l.Rows) {
+			rows[idx] = l.Rows[idx]
+		} else {
+			rows[idx] = l.AutoRows
+		}
+	}
+
+	padX := l.Padding.Left + l.Padding.Right
+	padY := l.Padding.Top + l.Padding.Bottom
+	minSize := layout.Pt(math.Max(ctx.MinSize.X-padX, 0), math.Max(ctx.MinSize.Y-padY, 0))
+	maxSize := layout.Pt(math.Max(ctx.MaxSize.X-padX, 0), math.Max(ctx.MaxSize.Y-padY, 0))
+
+	// Size the columns using the natural width of the children.
+	columnSpans := make([][2]int, len(areas))
+	for idx, i := range areas {
+		columnSpans[idx] = [2]int{i.column, i.columnSpan}
+	}
+	columnSizes := sizeTracks(columns, columnSpans, func(idx int) float64 {
+		g := ctx.LayoutChild(idx, layout.Pt(0, 0), layout.Pt(maxSize.X, math.Inf(1)))
+		return g.Width()
+	}, l.ColumnGap, minSize.X, maxSize.X)
+
+	// Size the rows using the height of the children at their column width.
+	rowSpans := make([][2]int, len(areas))
+	for idx, i := range areas {
+		rowSpans[idx] = [2]int{i.row, i.rowSpan}
+	}
+	rowSizes := sizeTracks(rows, rowSpans, func(idx int) float64 {
+		a := areas[idx]
+		w := spanSize(columnSizes, a.column, a.columnSpan, l.ColumnGap)
+		g := ctx.LayoutChild(idx, layout.Pt(w, 0), layout.Pt(w, math.Inf(1)))
+		return g.Height()
+	}, l.RowGap, minSize.Y, maxSize.Y)
+
+	// Position the children within their cells.
+	xs := offsets(columnSizes, l.Padding.Left, l.ColumnGap)
+	ys := offsets(rowSizes, l.Padding.Top, l.RowGap)
+	gs := make([]layout.Guide, len(areas))
+	for idx, i := range areas {
+		cell := l.cells[idx]
+		w := spanSize(columnSizes, i.column, i.columnSpan, l.ColumnGap)
+		h := spanSize(rowSizes, i.row, i.rowSpan, l.RowGap)
+
+		justify := cell.JustifySelf
+		if justify == AlignAuto {
+			justify = l.JustifyItems
+		}
+		align := cell.AlignSelf
+		if align == AlignAuto {
+			align = l.AlignItems
+		}
+
+		minChild := layout.Pt(0, 0)
+		if justify == AlignAuto || justify == AlignStretch {
+			minChild.X = w
+		}
+		if align == AlignAuto || align == AlignStretch {
+			minChild.Y = h
+		}
+		g := ctx.LayoutChild(idx, minChild, layout.Pt(w, h))
+		cw, ch := math.Min(g.Width(), w), math.Min(g.Height(), h)
+
+		x := xs[i.column] + alignOffset(justify, w, cw)
+		y := ys[i.row] + alignOffset(align, h, ch)
+		gs[idx] = layout.Guide{
+			Frame:  layout.Rt(x, y, x+cw, y+ch),
+			ZIndex: idx,
+		}
+	}
+
+	width := math.Min(math.Max(total(columnSizes, l.ColumnGap), minSize.X), maxSize.X)
+	height := math.Min(math.Max(total(rowSizes, l.RowGap), minSize.Y), maxSize.Y)
+	g := layout.Guide{Frame: layout.Rt(0, 0, width+padX, height+padY)}
+	for i := range gs {
+		gs[i].Frame = ctx.Direction.Mirror(gs[i].Frame, g.Width())
+	}
+	return g, gs
+}
+
+func alignOffset(a Align, cell, child float64) float64 {
+	switch a {
+	case AlignEnd:
+		return cell - child
+	case AlignCenter:
+		return (cell - child) / 2
+	}
+	return 0
+}
+
+// Notify implements the view.Layouter interface.
+func (l *Layouter) Notify(f func()) comm.Id {
+	return 0 // no-op
+}
+
+// Unnotify implements the view.Layouter interface.
+func (l *Layouter) Unnotify(id comm.Id) {
+	// no-op
+}
@@ -0,0 +1,173 @@
+package grid
+
+import (
+	"math"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+type child struct {
+	size layout.Point
+	cell func(*Cell)
+}
+
+func layoutCase(l *Layouter, children []child, min, max layout.Point, d layout.Direction) (layout.Guide, []layout.Guide) {
+	for _, i := range children {
+		l.Add(nil, i.cell)
+	}
+	ctx := &layout.Context{
+		MinSize:    min,
+		MaxSize:    max,
+		ChildCount: len(children),
+		Direction:  d,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			s := children[idx].size
+			s.X = math.Min(math.Max(s.X, min.X), max.X)
+			s.Y = math.Min(math.Max(s.Y, min.Y), max.Y)
+			return layout.Guide{Frame: layout.Rt(0, 0, s.X, s.Y)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+func sized(w, h float64) child {
+	return child{size: layout.Pt(w, h)}
+}
+
+var inf = math.Inf(1)
+
+func TestLayout(t *testing.T) {
+	cases := []struct {
+		name      string
+		layouter  *Layouter
+		children  []child
+		min, max  layout.Point
+		direction layout.Direction
+		frame     layout.Rect
+		frames    []layout.Rect
+	}{
+		{
+			name:     "fixed tracks",
+			layouter: &Layouter{Columns: []Track{Fixed(50), Fixed(30)}, AutoRows: Fixed(20)},
+			children: []child{sized(10, 10), sized(10, 10), sized(10, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 80, 40),
+			frames:   []layout.Rect{layout.Rt(0, 0, 50, 20), layout.Rt(50, 0, 80, 20), layout.Rt(0, 20, 50, 40)},
+		},
+		{
+			name:      "right to left",
+			layouter:  &Layouter{Columns: []Track{Fixed(50), Fixed(30)}, AutoRows: Fixed(20)},
+			children:  []child{sized(10, 10), sized(10, 10), sized(10, 10)},
+			max:       layout.Pt(inf, inf),
+			direction: layout.RightToLeft,
+			frame:     layout.Rt(0, 0, 80, 40),
+			frames:    []layout.Rect{layout.Rt(30, 0, 80, 20), layout.Rt(0, 0, 30, 20), layout.Rt(30, 20, 80, 40)},
+		},
+		{
+			name:     "content sized tracks",
+			layouter: &Layouter{Columns: []Track{{}, {}}, AlignItems: AlignStart, JustifyItems: AlignStart},
+			children: []child{sized(10, 5), sized(20, 10), sized(30, 15), sized(5, 5)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 50, 25),
+			frames:   []layout.Rect{layout.Rt(0, 0, 10, 5), layout.Rt(30, 0, 50, 10), layout.Rt(0, 10, 30, 25), layout.Rt(30, 10, 35, 15)},
+		},
+		{
+			name:     "flexible tracks",
+			layouter: &Layouter{Columns: []Track{Flexible(20, 40), Flexible(20, 40)}},
+			children: []child{sized(10, 10), sized(60, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 60, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 20, 10), layout.Rt(20, 0, 60, 10)},
+		},
+		{
+			name:     "fraction tracks",
+			layouter: &Layouter{Columns: []Track{Fixed(20), Fraction(1), Fraction(3)}},
+			children: []child{sized(10, 10), sized(10, 10), sized(10, 10)},
+			min:      layout.Pt(100, 0),
+			max:      layout.Pt(100, inf),
+			frame:    layout.Rt(0, 0, 100, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 20, 10), layout.Rt(20, 0, 40, 10), layout.Rt(40, 0, 100, 10)},
+		},
+		{
+			name:     "fraction tracks sized to content",
+			layouter: &Layouter{Columns: []Track{Fraction(1), Fraction(1)}},
+			children: []child{sized(10, 10), sized(30, 10)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 60, 10),
+			frames:   []layout.Rect{layout.Rt(0, 0, 30, 10), layout.Rt(30, 0, 60, 10)},
+		},
+		{
+			name:     "gaps and padding",
+			layouter: &Layouter{Columns: []Track{Fixed(10), Fixed(10)}, AutoRows: Fixed(10), ColumnGap: 5, RowGap: 2, Padding: layout.In(1, 1, 1, 1)},
+			children: []child{sized(0, 0), sized(0, 0), sized(0, 0)},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 27, 24),
+			frames:   []layout.Rect{layout.Rt(1, 1, 11, 11), layout.Rt(16, 1, 26, 11), layout.Rt(1, 13, 11, 23)},
+		},
+		{
+			name:     "spans",
+			layouter: &Layouter{Columns: []Track{Fixed(10), Fixed(10), Fixed(10)}, AutoRows: Fixed(10)},
+			children: []child{
+				{cell: func(c *Cell) { c.ColumnSpan = 2 }},
+				{cell: func(c *Cell) { c.RowSpan = 2 }},
+				{},
+				{},
+			},
+			max:    layout.Pt(inf, inf),
+			frame:  layout.Rt(0, 0, 30, 20),
+			frames: []layout.Rect{layout.Rt(0, 0, 20, 10), layout.Rt(20, 0, 30, 20), layout.Rt(0, 10, 10, 20), layout.Rt(10, 10, 20, 20)},
+		},
+		{
+			name:     "span grows content tracks",
+			layouter: &Layouter{Columns: []Track{{}, {}}},
+			children: []child{sized(10, 10), sized(10, 10), {size: layout.Pt(40, 10), cell: func(c *Cell) { c.ColumnSpan = 2 }}},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 40, 20),
+			frames:   []layout.Rect{layout.Rt(0, 0, 20, 10), layout.Rt(20, 0, 40, 10), layout.Rt(0, 10, 40, 20)},
+		},
+		{
+			name:     "explicit placement",
+			layouter: &Layouter{Columns: []Track{Fixed(10), Fixed(10)}, AutoRows: Fixed(10)},
+			children: []child{
+				{cell: func(c *Cell) { c.Row, c.Column = 1, 1 }},
+				{},
+				{cell: func(c *Cell) { c.Column = 1 }},
+			},
+			max:    layout.Pt(inf, inf),
+			frame:  layout.Rt(0, 0, 20, 20),
+			frames: []layout.Rect{layout.Rt(10, 10, 20, 20), layout.Rt(0, 0, 10, 10), layout.Rt(10, 0, 20, 10)},
+		},
+		{
+			name:     "alignment",
+			layouter: &Layouter{Columns: []Track{Fixed(20), Fixed(20)}, AutoRows: Fixed(20), JustifyItems: AlignCenter, AlignItems: AlignEnd},
+			children: []child{sized(10, 10), {size: layout.Pt(10, 10), cell: func(c *Cell) { c.JustifySelf, c.AlignSelf = AlignStretch, AlignStart }}},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 40, 20),
+			frames:   []layout.Rect{layout.Rt(5, 10, 15, 20), layout.Rt(20, 0, 40, 10)},
+		},
+		{
+			name:     "empty",
+			layouter: &Layouter{Columns: []Track{Fixed(10)}},
+			max:      layout.Pt(inf, inf),
+			frame:    layout.Rt(0, 0, 10, 0),
+			frames:   []layout.Rect{},
+		},
+	}
+
+	for _, c := range cases {
+		g, gs := layoutCase(c.layouter, c.children, c.min, c.max, c.direction)
+		if g.Frame != c.frame {
+			t.Errorf("%v: frame %v, expected %v", c.name, g.Frame, c.frame)
+		}
+		if len(gs) != len(c.frames) {
+			t.Errorf("%v: %v child guides, expected %v", c.name, len(gs), len(c.frames))
+			continue
+		}
+		for idx, i := range gs {
+			if i.Frame != c.frames[idx] {
+				t.Errorf("%v: child %v frame %v, expected %v", c.name, idx, i.Frame, c.frames[idx])
+			}
+		}
+	}
+}
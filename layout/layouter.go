@@ -65,16 +65,27 @@ type Layouter interface {
 	comm.Notifier
 }
 
+// Finisher is implemented by Layouters that need to know the result of the layout phase. A parent may lay out a view
+// several times with different sizes while measuring it. After the layout phase, FinishLayout is called with the
+// minimum and maximum size of the view's final layout.
+type Finisher interface {
+	Layouter
+	FinishLayout(minSize, maxSize Point)
+}
+
 type Context struct {
 	MinSize    Point
 	MaxSize    Point
 	ChildCount int
 	LayoutFunc func(int, Point, Point) Guide
+	// Direction is the layout direction of the view. Layouters that support right-to-left layouts place their first
+	// child on the right when it is RightToLeft.
+	Direction Direction
 }
 
 func (l *Context) LayoutChild(idx int, minSize, maxSize Point) Guide {
 	g := l.LayoutFunc(idx, minSize, maxSize)
-	g.Frame = g.Frame.Add(Pt(-g.Frame.Min.X, -g.Frame.Min.Y))
+	g.Frame = g.Frame.Sub(g.Frame.Min)
 	return g
 }
 
@@ -82,14 +93,38 @@ func (l *Context) LayoutChild(idx int, minSize, maxSize Point) Guide {
 type Guide struct {
 	Frame  Rect
 	ZIndex int
+	// Transform is applied to the view around its anchor point after it is positioned at Frame. It is used for hit
+	// testing and by the snapshot package, but the iOS client does not render it yet.
+	Transform Transform
 }
 
 // MarshalProtobuf serializes g into a protobuf object.
 func (g Guide) MarshalProtobuf() *pblayout.Guide {
+	var transform *pblayout.Transform
+	if g.Transform != (Transform{}) {
+		transform = g.Transform.MarshalProtobuf()
+	}
 	return &pblayout.Guide{
-		Frame:  g.Frame.MarshalProtobuf(),
-		ZIndex: int64(g.ZIndex),
+		Frame:     g.Frame.MarshalProtobuf(),
+		ZIndex:    int64(g.ZIndex),
+		Transform: transform,
+	}
+}
+
+// ToParent converts p from the coordinate space of g into the coordinate space of g's parent.
+func (g Guide) ToParent(p Point) Point {
+	return g.Transform.InFrame(g.Frame).Apply(Pt(p.X+g.Frame.Min.X, p.Y+g.Frame.Min.Y))
+}
+
+// ToLocal converts p from the coordinate space of g's parent into the coordinate space of g. It returns false if
+// g.Transform is not invertible.
+func (g Guide) ToLocal(p Point) (Point, bool) {
+	t, ok := g.Transform.InFrame(g.Frame).Invert()
+	if !ok {
+		return Point{}, false
 	}
+	p = t.Apply(p)
+	return Pt(p.X-g.Frame.Min.X, p.Y-g.Frame.Min.Y), true
 }
 
 // Left returns the left edge of g.
@@ -124,12 +159,12 @@ func (g Guide) Height() float64 {
 
 // CenterX returns the horizontal center of g.
 func (g Guide) CenterX() float64 {
-	return (g.Frame.Max.X - g.Frame.Min.X) / 2
+	return (g.Frame.Min.X + g.Frame.Max.X) / 2
 }
 
 // CenterY returns the vertical center of g.
 func (g Guide) CenterY() float64 {
-	return (g.Frame.Max.Y - g.Frame.Min.Y) / 2
+	return (g.Frame.Min.Y + g.Frame.Max.Y) / 2
 }
 
 // Fit adjusts the frame of the guide to be within MinSize and MaxSize of the LayoutContext.
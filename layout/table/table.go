@@ -1,5 +1,5 @@
 /*
-Package table implements a vertical, single column layout system. Views are layed out from top to bottom.
+Package table implements a single column layout system. Views are layed out from top to bottom, or from left to right.
 
  l := &table.Layouter{}
 
@@ -10,6 +10,19 @@ Package table implements a vertical, single column layout system. Views are laye
  	Views: l.Views(),
  	Layouter:l,
  }
+
+Children can be given a ScrollBehavior to adjust their position as the enclosing scroll view scrolls.
+The same ScrollPosition must be set on both the scroll view and the layouter.
+
+ position := &scrollview.ScrollPosition{}
+ l := &table.Layouter{ScrollPosition: position}
+ l.Add(sectionHeader, &table.StickyHeader{}) // Pinned to the top of the scroll view until the next header arrives.
+ l.Add(row, nil)
+
+ scrollView := scrollview.New()
+ scrollView.ScrollPosition = position
+ scrollView.ContentLayouter = l
+ scrollView.ContentChildren = l.Views()
 */
 package table
 
@@ -19,13 +32,83 @@ import (
 	"gomatcha.io/matcha/comm"
 	"gomatcha.io/matcha/layout"
 	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/scrollview"
+)
+
+// Direction is the axis along which children are placed.
+type Direction int
+
+const (
+	// Vertical places children from top to bottom.
+	Vertical Direction = iota
+	// Horizontal places children from left to right, or from right to left in a right-to-left layout direction.
+	Horizontal
 )
 
+// ScrollBehavior adjusts the position of a child as the table is scrolled.
 type ScrollBehavior interface {
+	// scrollOffset returns the adjusted position of the child along the main axis.
+	scrollOffset(ctx *behaviorContext, idx int) float64
+}
+
+// behaviorContext contains the unadjusted positions of all children along the main axis.
+type behaviorContext struct {
+	behaviors    []ScrollBehavior
+	starts       []float64
+	sizes        []float64
+	contentStart float64
+	contentEnd   float64
+	visibleStart float64
+	visibleEnd   float64
+}
+
+// StickyHeader pins a child to the leading edge of the visible region, until it is pushed out by the next StickyHeader.
+type StickyHeader struct {
+}
+
+func (b *StickyHeader) scrollOffset(ctx *behaviorContext, idx int) float64 {
+	sectionEnd := ctx.contentEnd
+	for i := idx + 1; i < len(ctx.behaviors); i++ {
+		if _, ok := ctx.behaviors[i].(*StickyHeader); ok {
+			sectionEnd = ctx.starts[i]
+			break
+		}
+	}
+	pos := math.Max(ctx.starts[idx], ctx.visibleStart)
+	return math.Max(math.Min(pos, sectionEnd-ctx.sizes[idx]), ctx.starts[idx])
+}
+
+// StickyFooter pins a child to the trailing edge of the visible region, until it is pushed out by the previous StickyFooter.
+type StickyFooter struct {
+}
+
+func (b *StickyFooter) scrollOffset(ctx *behaviorContext, idx int) float64 {
+	if math.IsInf(ctx.visibleEnd, 0) {
+		return ctx.starts[idx]
+	}
+	sectionStart := ctx.contentStart
+	for i := idx - 1; i >= 0; i-- {
+		if _, ok := ctx.behaviors[i].(*StickyFooter); ok {
+			sectionStart = ctx.starts[i] + ctx.sizes[i]
+			break
+		}
+	}
+	pos := math.Min(ctx.starts[idx], ctx.visibleEnd-ctx.sizes[idx])
+	return math.Min(math.Max(pos, sectionStart), ctx.starts[idx])
 }
 
 type Layouter struct {
-	views []view.View
+	Direction Direction
+	// Spacing is the space between consecutive children.
+	Spacing float64
+	// Insets are the space around the children. In a right-to-left layout direction, the children are mirrored, so Left
+	// and Right are the leading and trailing insets.
+	Insets layout.Insets
+	// ScrollPosition is the position of the enclosing scroll view. It is required for any ScrollBehaviors.
+	ScrollPosition *scrollview.ScrollPosition
+
+	views     []view.View
+	behaviors []ScrollBehavior
 }
 
 // Views returns all views that have been added to l.
@@ -33,34 +116,112 @@ func (l *Layouter) Views() []view.View {
 	return l.views
 }
 
-// Add adds v to the layouter and positions it with g.
+// Add adds v to the layouter. If b is non-nil, it adjusts the position of v as the table scrolls.
 func (l *Layouter) Add(v view.View, b ScrollBehavior) {
 	l.views = append(l.views, v)
+	l.behaviors = append(l.behaviors, b)
+}
+
+func (l *Layouter) hasBehaviors() bool {
+	for _, i := range l.behaviors {
+		if i != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // Layout implements the view.Layouter interface.
 func (l *Layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
-	g := layout.Guide{}
-	gs := []layout.Guide{}
-	y := 0.0
-	x := ctx.MinSize.X
+	horizontal := l.Direction == Horizontal
+	mainStart, mainEnd, crossStart, crossEnd := l.Insets.Top, l.Insets.Bottom, l.Insets.Left, l.Insets.Right
+	cross := ctx.MinSize.X
+	if horizontal {
+		mainStart, mainEnd, crossStart, crossEnd = l.Insets.Left, l.Insets.Right, l.Insets.Top, l.Insets.Bottom
+		cross = ctx.MinSize.Y
+	}
+	childCross := math.Max(cross-crossStart-crossEnd, 0)
+
+	bctx := &behaviorContext{
+		behaviors:    l.behaviors,
+		starts:       make([]float64, len(l.views)),
+		sizes:        make([]float64, len(l.views)),
+		contentStart: mainStart,
+	}
+
+	// Place the children one after another.
+	gs := make([]layout.Guide, len(l.views))
+	main := mainStart
 	for i := range l.views {
-		g := ctx.LayoutChild(i, layout.Pt(x, 0), layout.Pt(x, math.Inf(1)))
-		g.Frame = layout.Rt(0, y, g.Width(), y+g.Height())
+		if i > 0 {
+			main += l.Spacing
+		}
+		var g layout.Guide
+		if horizontal {
+			g = ctx.LayoutChild(i, layout.Pt(0, childCross), layout.Pt(math.Inf(1), childCross))
+			bctx.sizes[i] = g.Width()
+		} else {
+			g = ctx.LayoutChild(i, layout.Pt(childCross, 0), layout.Pt(childCross, math.Inf(1)))
+			bctx.sizes[i] = g.Height()
+		}
+		bctx.starts[i] = main
 		g.ZIndex = i
-		gs = append(gs, g)
-		y += g.Height()
+		gs[i] = g
+		main += bctx.sizes[i]
+	}
+	bctx.contentEnd = main
+
+	// Adjust the children with scroll behaviors.
+	if l.ScrollPosition != nil && l.hasBehaviors() {
+		offset, viewport := l.ScrollPosition.Value(), l.ScrollPosition.ViewportSize()
+		bctx.visibleStart, bctx.visibleEnd = offset.Y, offset.Y+viewport.Y
+		if horizontal {
+			bctx.visibleStart, bctx.visibleEnd = offset.X, offset.X+viewport.X
+		}
+		if bctx.visibleEnd <= bctx.visibleStart {
+			bctx.visibleEnd = math.Inf(1) // viewport size is unknown
+		} else if horizontal && ctx.Direction == layout.RightToLeft {
+			// The children are mirrored, so the visible region is measured from the right.
+			end := main + mainEnd
+			bctx.visibleStart, bctx.visibleEnd = end-bctx.visibleEnd, end-bctx.visibleStart
+		}
+	}
+
+	for i := range l.views {
+		start := bctx.starts[i]
+		if b := l.behaviors[i]; b != nil && l.ScrollPosition != nil {
+			start = b.scrollOffset(bctx, i)
+			gs[i].ZIndex = len(l.views) + i // Draw above the other children.
+		}
+		if horizontal {
+			gs[i].Frame = layout.Rt(start, crossStart, start+bctx.sizes[i], crossStart+gs[i].Height())
+		} else {
+			gs[i].Frame = layout.Rt(crossStart, start, crossStart+gs[i].Width(), start+bctx.sizes[i])
+		}
+	}
+
+	g := layout.Guide{Frame: layout.Rt(0, 0, cross, main+mainEnd)}
+	if horizontal {
+		g.Frame = layout.Rt(0, 0, main+mainEnd, cross)
+	}
+	for i := range gs {
+		gs[i].Frame = ctx.Direction.Mirror(gs[i].Frame, g.Width())
 	}
-	g.Frame = layout.Rt(0, 0, x, y)
 	return g, gs
 }
 
-// Notify implements the view.Layouter interface.
+// Notify implements the view.Layouter interface. If any children have a ScrollBehavior, f is called when the ScrollPosition changes.
 func (l *Layouter) Notify(f func()) comm.Id {
-	return 0 // no-op
+	if l.ScrollPosition == nil || !l.hasBehaviors() {
+		return 0
+	}
+	return l.ScrollPosition.Notify(f)
 }
 
 // Unnotify implements the view.Layouter interface.
 func (l *Layouter) Unnotify(id comm.Id) {
-	// no-op
+	if id == 0 {
+		return
+	}
+	l.ScrollPosition.Unnotify(id)
 }
@@ -0,0 +1,112 @@
+package table
+
+import (
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view/scrollview"
+)
+
+func layoutTable(l *Layouter, sizes []layout.Point, min layout.Point) (layout.Guide, []layout.Guide) {
+	ctx := &layout.Context{
+		MinSize:    min,
+		MaxSize:    min,
+		ChildCount: len(sizes),
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			s := sizes[idx]
+			if min.X == max.X {
+				s.X = min.X
+			}
+			if min.Y == max.Y {
+				s.Y = min.Y
+			}
+			return layout.Guide{Frame: layout.Rt(0, 0, s.X, s.Y)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+func TestVertical(t *testing.T) {
+	l := &Layouter{Spacing: 5, Insets: layout.In(1, 2, 3, 4)}
+	l.Add(nil, nil)
+	l.Add(nil, nil)
+	g, gs := layoutTable(l, []layout.Point{layout.Pt(0, 10), layout.Pt(0, 20)}, layout.Pt(100, 0))
+
+	if g.Frame != layout.Rt(0, 0, 100, 39) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(2, 1, 96, 11) || gs[1].Frame != layout.Rt(2, 16, 96, 36) {
+		t.Errorf("Incorrect child frames: %v", gs)
+	}
+}
+
+func TestHorizontal(t *testing.T) {
+	l := &Layouter{Direction: Horizontal, Spacing: 5}
+	l.Add(nil, nil)
+	l.Add(nil, nil)
+	g, gs := layoutTable(l, []layout.Point{layout.Pt(10, 0), layout.Pt(20, 0)}, layout.Pt(0, 50))
+
+	if g.Frame != layout.Rt(0, 0, 35, 50) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(0, 0, 10, 50) || gs[1].Frame != layout.Rt(15, 0, 35, 50) {
+		t.Errorf("Incorrect child frames: %v", gs)
+	}
+}
+
+func TestRightToLeft(t *testing.T) {
+	l := &Layouter{Direction: Horizontal, Spacing: 5, Insets: layout.In(0, 0, 0, 2)}
+	l.Add(nil, nil)
+	l.Add(nil, nil)
+	sizes := []layout.Point{layout.Pt(10, 0), layout.Pt(20, 0)}
+	ctx := &layout.Context{
+		MinSize:    layout.Pt(0, 50),
+		MaxSize:    layout.Pt(0, 50),
+		ChildCount: len(sizes),
+		Direction:  layout.RightToLeft,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			return layout.Guide{Frame: layout.Rt(0, 0, sizes[idx].X, min.Y)}
+		},
+	}
+	g, gs := l.Layout(ctx)
+
+	// The first child is placed at the right edge, and the right inset is on the left.
+	if g.Frame != layout.Rt(0, 0, 37, 50) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(27, 0, 37, 50) || gs[1].Frame != layout.Rt(2, 0, 22, 50) {
+		t.Errorf("Incorrect child frames: %v", gs)
+	}
+}
+
+func TestStickyHeader(t *testing.T) {
+	position := &scrollview.ScrollPosition{}
+	l := &Layouter{ScrollPosition: position}
+	l.Add(nil, &StickyHeader{})
+	l.Add(nil, nil)
+	l.Add(nil, &StickyHeader{})
+	l.Add(nil, nil)
+	sizes := []layout.Point{layout.Pt(0, 10), layout.Pt(0, 100), layout.Pt(0, 10), layout.Pt(0, 100)}
+
+	cases := []struct {
+		offset  float64
+		header1 float64
+		header2 float64
+	}{
+		{0, 0, 110},
+		{50, 50, 110},
+		{105, 100, 110}, // First header is pushed up by the second.
+		{150, 100, 150},
+		{300, 100, 210}, // Second header stops at the end of its section.
+	}
+	for _, i := range cases {
+		position.SetValue(layout.Pt(0, i.offset))
+		_, gs := layoutTable(l, sizes, layout.Pt(100, 0))
+		if gs[0].Frame.Min.Y != i.header1 || gs[2].Frame.Min.Y != i.header2 {
+			t.Errorf("Offset %v: incorrect header positions %v, %v", i.offset, gs[0].Frame, gs[2].Frame)
+		}
+		if gs[0].ZIndex <= gs[1].ZIndex || gs[2].ZIndex <= gs[3].ZIndex {
+			t.Errorf("Offset %v: headers are not above rows", i.offset)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package layout
+
+import (
+	"fmt"
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	pblayout "gomatcha.io/matcha/pb/layout"
+)
+
+// Transform is a 3D transformation of a view, such as a translation, scale, rotation or perspective. It is stored as a
+// 4x4 matrix that is applied to row vectors, matching CATransform3D, and it is applied around an anchor point, which is
+// the center of the view by default. The zero value is the identity transform.
+//
+//	// Doubles the size of the view and rotates it 45 degrees clockwise around its center.
+//	t := layout.Transform{}.Scale(2, 2, 1).Rotate(math.Pi/4, 0, 0, 1)
+type Transform struct {
+	// m is the difference from the identity matrix, and anchor is the difference from the center, so that the zero
+	// value is the identity transform.
+	m      [4][4]float64
+	anchor Point
+}
+
+// NewTransform creates a transform with matrix m.
+func NewTransform(m [4][4]float64) Transform {
+	for i := 0; i < 4; i++ {
+		m[i][i] -= 1
+	}
+	return Transform{m: m}
+}
+
+// Matrix returns the matrix of t.
+func (t Transform) Matrix() [4][4]float64 {
+	m := t.m
+	for i := 0; i < 4; i++ {
+		m[i][i] += 1
+	}
+	return m
+}
+
+// Anchor returns the point that t is applied around, in unit coordinates of the view's frame. (0, 0) is the top left
+// corner and (1, 1) is the bottom right corner.
+func (t Transform) Anchor() Point {
+	return Pt(t.anchor.X+0.5, t.anchor.Y+0.5)
+}
+
+// WithAnchor returns t, applied around the anchor point p.
+func (t Transform) WithAnchor(p Point) Transform {
+	t.anchor = Pt(p.X-0.5, p.Y-0.5)
+	return t
+}
+
+// IsIdentity returns true if t does not transform points.
+func (t Transform) IsIdentity() bool {
+	return t.m == [4][4]float64{}
+}
+
+// Concat returns the transform that applies t followed by u. The result has t's anchor point.
+func (t Transform) Concat(u Transform) Transform {
+	a, b := t.Matrix(), u.Matrix()
+	m := [4][4]float64{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				m[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	n := NewTransform(m)
+	n.anchor = t.anchor
+	return n
+}
+
+// Translate returns t followed by a translation of x, y and z.
+func (t Transform) Translate(x, y, z float64) Transform {
+	m := Transform{}.Matrix()
+	m[3][0], m[3][1], m[3][2] = x, y, z
+	return t.Concat(NewTransform(m))
+}
+
+// Scale returns t followed by a scale of x, y and z.
+func (t Transform) Scale(x, y, z float64) Transform {
+	m := Transform{}.Matrix()
+	m[0][0], m[1][1], m[2][2] = x, y, z
+	return t.Concat(NewTransform(m))
+}
+
+// Rotate returns t followed by a rotation of angle radians around the axis x, y, z. A positive rotation around the z
+// axis is clockwise on screen.
+func (t Transform) Rotate(angle, x, y, z float64) Transform {
+	length := math.Sqrt(x*x + y*y + z*z)
+	if length == 0 {
+		return t
+	}
+	x, y, z = x/length, y/length, z/length
+	s, c := math.Sin(angle), math.Cos(angle)
+
+	m := Transform{}.Matrix()
+	m[0][0] = c + (1-c)*x*x
+	m[0][1] = (1-c)*x*y + s*z
+	m[0][2] = (1-c)*x*z - s*y
+	m[1][0] = (1-c)*x*y - s*z
+	m[1][1] = c + (1-c)*y*y
+	m[1][2] = (1-c)*y*z + s*x
+	m[2][0] = (1-c)*x*z + s*y
+	m[2][1] = (1-c)*y*z - s*x
+	m[2][2] = c + (1-c)*z*z
+	return t.Concat(NewTransform(m))
+}
+
+// Perspective returns t followed by a perspective projection, where the viewer is distance points away from the
+// screen.
+func (t Transform) Perspective(distance float64) Transform {
+	if distance == 0 {
+		return t
+	}
+	m := Transform{}.Matrix()
+	m[2][3] = -1 / distance
+	return t.Concat(NewTransform(m))
+}
+
+// Invert returns the inverse of t, and false if t is not invertible. The result has t's anchor point.
+func (t Transform) Invert() (Transform, bool) {
+	a := t.Matrix()
+	b := Transform{}.Matrix()
+
+	// Gauss-Jordan elimination with partial pivoting.
+	for col := 0; col < 4; col++ {
+		pivot := col
+		for row := col + 1; row < 4; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return Transform{}, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		d := a[col][col]
+		for j := 0; j < 4; j++ {
+			a[col][j] /= d
+			b[col][j] /= d
+		}
+		for row := 0; row < 4; row++ {
+			if row == col {
+				continue
+			}
+			f := a[row][col]
+			for j := 0; j < 4; j++ {
+				a[row][j] -= f * a[col][j]
+				b[row][j] -= f * b[col][j]
+			}
+		}
+	}
+	n := NewTransform(b)
+	n.anchor = t.anchor
+	return n, true
+}
+
+// Apply returns p, on the z = 0 plane, multiplied by t's matrix and projected back onto the plane. The anchor point is
+// not taken into account; use InFrame to transform points around a view's anchor point.
+func (t Transform) Apply(p Point) Point {
+	m := t.Matrix()
+	x := p.X*m[0][0] + p.Y*m[1][0] + m[3][0]
+	y := p.X*m[0][1] + p.Y*m[1][1] + m[3][1]
+	w := p.X*m[0][3] + p.Y*m[1][3] + m[3][3]
+	if w == 0 {
+		return Pt(math.Inf(1), math.Inf(1))
+	}
+	return Pt(x/w, y/w)
+}
+
+// InFrame returns t as applied to a view with the given frame, in the coordinate space of frame. The result is
+// meant to be used with Apply, which ignores the anchor point.
+func (t Transform) InFrame(frame Rect) Transform {
+	anchor := t.Anchor()
+	x := frame.Min.X + (frame.Max.X-frame.Min.X)*anchor.X
+	y := frame.Min.Y + (frame.Max.Y-frame.Min.Y)*anchor.Y
+	t.anchor = Point{}
+	return Transform{}.Translate(-x, -y, 0).Concat(t).Translate(x, y, 0)
+}
+
+// String returns a string description of t.
+func (t Transform) String() string {
+	return fmt.Sprintf("Transform{%v, Anchor:%v}", t.Matrix(), t.Anchor())
+}
+
+// MarshalProtobuf serializes t into a protobuf object.
+func (t *Transform) MarshalProtobuf() *pblayout.Transform {
+	m := t.Matrix()
+	anchor := t.Anchor()
+	return &pblayout.Transform{
+		M11: m[0][0], M12: m[0][1], M13: m[0][2], M14: m[0][3],
+		M21: m[1][0], M22: m[1][1], M23: m[1][2], M24: m[1][3],
+		M31: m[2][0], M32: m[2][1], M33: m[2][2], M34: m[2][3],
+		M41: m[3][0], M42: m[3][1], M43: m[3][2], M44: m[3][3],
+		Anchor: anchor.MarshalProtobuf(),
+	}
+}
+
+// UnmarshalProtobuf deserializes t from a protobuf object.
+func (t *Transform) UnmarshalProtobuf(pbt *pblayout.Transform) {
+	*t = NewTransform([4][4]float64{
+		{pbt.M11, pbt.M12, pbt.M13, pbt.M14},
+		{pbt.M21, pbt.M22, pbt.M23, pbt.M24},
+		{pbt.M31, pbt.M32, pbt.M33, pbt.M34},
+		{pbt.M41, pbt.M42, pbt.M43, pbt.M44},
+	})
+	if pbt.Anchor != nil {
+		var anchor Point
+		anchor.UnmarshalProtobuf(pbt.Anchor)
+		*t = t.WithAnchor(anchor)
+	}
+}
+
+// TransformNotifier wraps the comm.Notifier interface with an additional Value() method which returns a Transform.
+type TransformNotifier interface {
+	comm.Notifier
+	Value() Transform
+}
@@ -0,0 +1,82 @@
+package layout
+
+import (
+	"math"
+	"testing"
+)
+
+func pointsEqual(a, b Point) bool {
+	return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9
+}
+
+func TestTransform(t *testing.T) {
+	if !(Transform{}).IsIdentity() || (Transform{}).Anchor() != Pt(0.5, 0.5) {
+		t.Error("Zero value is not the identity")
+	}
+	if NewTransform(Transform{}.Matrix()) != (Transform{}) {
+		t.Error("NewTransform does not round trip")
+	}
+
+	for _, i := range []struct {
+		t      Transform
+		p, out Point
+	}{
+		{Transform{}.Translate(10, 20, 0), Pt(1, 2), Pt(11, 22)},
+		{Transform{}.Scale(2, 3, 1), Pt(1, 2), Pt(2, 6)},
+		{Transform{}.Rotate(math.Pi/2, 0, 0, 1), Pt(1, 0), Pt(0, 1)},
+		{Transform{}.Scale(2, 2, 1).Translate(10, 0, 0), Pt(1, 1), Pt(12, 2)},
+		{Transform{}.Translate(10, 0, 0).Scale(2, 2, 1), Pt(1, 1), Pt(22, 2)},
+		{Transform{}.Rotate(math.Pi, 0, 1, 0).Perspective(100), Pt(1, 1), Pt(-1, 1)},
+	} {
+		if p := i.t.Apply(i.p); !pointsEqual(p, i.out) {
+			t.Errorf("%v: Apply(%v) = %v, expected %v", i.t, i.p, p, i.out)
+		}
+		inv, ok := i.t.Invert()
+		if !ok {
+			t.Errorf("%v: not invertible", i.t)
+		} else if p := inv.Apply(i.out); !pointsEqual(p, i.p) {
+			t.Errorf("%v: inverse Apply(%v) = %v, expected %v", i.t, i.out, p, i.p)
+		}
+	}
+
+	if _, ok := (Transform{}).Scale(0, 1, 1).Invert(); ok {
+		t.Error("Singular transform was inverted")
+	}
+}
+
+func TestTransformInFrame(t *testing.T) {
+	frame := Rt(10, 10, 30, 50)
+
+	// Scaled around the center.
+	tr := Transform{}.Scale(2, 2, 1).InFrame(frame)
+	if p := tr.Apply(Pt(10, 10)); !pointsEqual(p, Pt(0, -10)) {
+		t.Errorf("Incorrect point: %v", p)
+	}
+
+	// Scaled around the top left corner.
+	tr = Transform{}.Scale(2, 2, 1).WithAnchor(Pt(0, 0)).InFrame(frame)
+	if p := tr.Apply(Pt(30, 50)); !pointsEqual(p, Pt(50, 90)) {
+		t.Errorf("Incorrect point: %v", p)
+	}
+}
+
+func TestGuideConvert(t *testing.T) {
+	g := Guide{Frame: Rt(100, 100, 200, 150), Transform: Transform{}.Rotate(math.Pi/2, 0, 0, 1)}
+
+	// The top left corner is rotated around the center to the top right.
+	if p := g.ToParent(Pt(0, 0)); !pointsEqual(p, Pt(175, 75)) {
+		t.Errorf("Incorrect parent point: %v", p)
+	}
+	if p, ok := g.ToLocal(Pt(175, 75)); !ok || !pointsEqual(p, Pt(0, 0)) {
+		t.Errorf("Incorrect local point: %v", p)
+	}
+}
+
+func TestTransformProtobuf(t *testing.T) {
+	tr := Transform{}.Rotate(1, 1, 2, 3).Perspective(500).WithAnchor(Pt(0, 1))
+	var tr2 Transform
+	tr2.UnmarshalProtobuf(tr.MarshalProtobuf())
+	if tr2 != tr {
+		t.Errorf("Incorrect transform: %v, expected %v", tr2, tr)
+	}
+}
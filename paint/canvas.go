@@ -0,0 +1,491 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/pb"
+	pblayout "gomatcha.io/matcha/pb/layout"
+	"gomatcha.io/matcha/pb/paint"
+	"gomatcha.io/matcha/text"
+)
+
+type pathOp int
+
+const (
+	moveOp pathOp = iota
+	lineOp
+	quadOp
+	cubicOp
+	closeOp
+)
+
+type pathElement struct {
+	op     pathOp
+	points []layout.Point
+}
+
+// Path is a shape made of lines and curves, in the coordinate space of a Canvas. Shapes are filled with the nonzero
+// winding rule.
+//
+//	p := &paint.Path{}
+//	p.MoveTo(layout.Pt(0, 0))
+//	p.LineTo(layout.Pt(10, 0))
+//	p.Arc(layout.Pt(10, 10), 10, -math.Pi/2, 0)
+//	p.Close()
+type Path struct {
+	elements []pathElement
+	current  layout.Point
+	start    layout.Point
+}
+
+// IsEmpty returns true if p has no elements.
+func (p *Path) IsEmpty() bool {
+	return len(p.elements) == 0
+}
+
+// Current returns the end point of the last element of p.
+func (p *Path) Current() layout.Point {
+	return p.current
+}
+
+// MoveTo starts a new subpath at pt.
+func (p *Path) MoveTo(pt layout.Point) {
+	p.elements = append(p.elements, pathElement{op: moveOp, points: []layout.Point{pt}})
+	p.current, p.start = pt, pt
+}
+
+// LineTo adds a line from the current point to pt.
+func (p *Path) LineTo(pt layout.Point) {
+	p.elements = append(p.elements, pathElement{op: lineOp, points: []layout.Point{pt}})
+	p.current = pt
+}
+
+// QuadTo adds a quadratic bezier curve from the current point to pt, with the control point c.
+func (p *Path) QuadTo(c, pt layout.Point) {
+	p.elements = append(p.elements, pathElement{op: quadOp, points: []layout.Point{c, pt}})
+	p.current = pt
+}
+
+// CubicTo adds a cubic bezier curve from the current point to pt, with the control points c1 and c2.
+func (p *Path) CubicTo(c1, c2, pt layout.Point) {
+	p.elements = append(p.elements, pathElement{op: cubicOp, points: []layout.Point{c1, c2, pt}})
+	p.current = pt
+}
+
+// Close adds a line from the current point to the start of the subpath, and ends the subpath.
+func (p *Path) Close() {
+	p.elements = append(p.elements, pathElement{op: closeOp})
+	p.current = p.start
+}
+
+// Arc adds a circular arc with center and radius, from the angle start to the angle end in radians. Angles are
+// measured clockwise from the positive x axis, and the arc is drawn clockwise if end is greater than start. If the path
+// is empty, the arc starts a new subpath. Otherwise a line is added from the current point to the start of the arc.
+func (p *Path) Arc(center layout.Point, radius, start, end float64) {
+	pt := func(angle float64) layout.Point {
+		return layout.Pt(center.X+radius*math.Cos(angle), center.Y+radius*math.Sin(angle))
+	}
+	if p.IsEmpty() {
+		p.MoveTo(pt(start))
+	} else {
+		p.LineTo(pt(start))
+	}
+
+	// Approximate the arc with cubic curves of at most a quarter turn each.
+	count := int(math.Ceil(math.Abs(end-start) / (math.Pi / 2)))
+	step := (end - start) / float64(count)
+	k := 4.0 / 3 * math.Tan(step/4)
+	for i := 0; i < count; i++ {
+		a0 := start + step*float64(i)
+		a1 := a0 + step
+		p0, p1 := pt(a0), pt(a1)
+		c1 := layout.Pt(p0.X-k*radius*math.Sin(a0), p0.Y+k*radius*math.Cos(a0))
+		c2 := layout.Pt(p1.X+k*radius*math.Sin(a1), p1.Y-k*radius*math.Cos(a1))
+		p.CubicTo(c1, c2, p1)
+	}
+}
+
+// AddRect adds r to p as a closed subpath.
+func (p *Path) AddRect(r layout.Rect) {
+	p.MoveTo(r.Min)
+	p.LineTo(layout.Pt(r.Max.X, r.Min.Y))
+	p.LineTo(r.Max)
+	p.LineTo(layout.Pt(r.Min.X, r.Max.Y))
+	p.Close()
+}
+
+// AddRoundedRect adds r with corners of the given radius to p as a closed subpath.
+func (p *Path) AddRoundedRect(r layout.Rect, radius float64) {
+	p.AddRoundedRectCorners(r, Radii(CornerAll, radius))
+}
+
+// AddRoundedRectCorners adds r with the given corner radii to p as a closed subpath. The radii are scaled down to fit
+// in r.
+func (p *Path) AddRoundedRectCorners(r layout.Rect, c CornerRadii) {
+	c = c.Fit(r.Size())
+	if c.IsZero() {
+		p.AddRect(r)
+		return
+	}
+	corner := func(center layout.Point, radius, start float64) {
+		if radius <= 0 {
+			p.LineTo(center)
+			return
+		}
+		p.Arc(center, radius, start, start+math.Pi/2)
+	}
+	p.MoveTo(layout.Pt(r.Min.X+c.TopLeft, r.Min.Y))
+	corner(layout.Pt(r.Max.X-c.TopRight, r.Min.Y+c.TopRight), c.TopRight, -math.Pi/2)
+	corner(layout.Pt(r.Max.X-c.BottomRight, r.Max.Y-c.BottomRight), c.BottomRight, 0)
+	corner(layout.Pt(r.Min.X+c.BottomLeft, r.Max.Y-c.BottomLeft), c.BottomLeft, math.Pi/2)
+	corner(layout.Pt(r.Min.X+c.TopLeft, r.Min.Y+c.TopLeft), c.TopLeft, math.Pi)
+	p.Close()
+}
+
+// AddEllipse adds the ellipse that fits in r to p as a closed subpath.
+func (p *Path) AddEllipse(r layout.Rect) {
+	c := r.Center()
+	rx, ry := r.Width()/2, r.Height()/2
+	if rx <= 0 || ry <= 0 {
+		return
+	}
+	// Draw a unit circle and scale it into place.
+	circle := &Path{}
+	circle.Arc(layout.Pt(0, 0), 1, 0, 2*math.Pi)
+	circle.Close()
+	for _, i := range circle.elements {
+		points := make([]layout.Point, len(i.points))
+		for idx, j := range i.points {
+			points[idx] = layout.Pt(c.X+j.X*rx, c.Y+j.Y*ry)
+		}
+		p.elements = append(p.elements, pathElement{op: i.op, points: points})
+	}
+	p.start = layout.Pt(c.X+rx, c.Y)
+	p.current = p.start
+}
+
+// MarshalProtobuf serializes p into a protobuf object.
+func (p *Path) MarshalProtobuf() *paint.Path {
+	elements := make([]*paint.PathElement, 0, len(p.elements))
+	for _, i := range p.elements {
+		points := make([]*pblayout.Point, 0, len(i.points))
+		for _, j := range i.points {
+			points = append(points, j.MarshalProtobuf())
+		}
+		elements = append(elements, &paint.PathElement{Op: paint.PathOp(i.op), Points: points})
+	}
+	return &paint.Path{Elements: elements}
+}
+
+// UnmarshalProtobuf deserializes p from a protobuf object.
+func (p *Path) UnmarshalProtobuf(pbp *paint.Path) {
+	*p = Path{}
+	for _, i := range pbp.Elements {
+		points := make([]layout.Point, len(i.Points))
+		for idx, j := range i.Points {
+			points[idx].UnmarshalProtobuf(j)
+		}
+		switch op := pathOp(i.Op); {
+		case op == closeOp:
+			p.Close()
+		case op == moveOp && len(points) == 1:
+			p.MoveTo(points[0])
+		case op == lineOp && len(points) == 1:
+			p.LineTo(points[0])
+		case op == quadOp && len(points) == 2:
+			p.QuadTo(points[0], points[1])
+		case op == cubicOp && len(points) == 3:
+			p.CubicTo(points[0], points[1], points[2])
+		}
+	}
+}
+
+// LineCap is the shape of the ends of stroked lines.
+type LineCap int
+
+const (
+	LineCapButt LineCap = iota
+	LineCapRound
+	LineCapSquare
+)
+
+// LineJoin is the shape of the corners of stroked lines.
+type LineJoin int
+
+const (
+	LineJoinMiter LineJoin = iota
+	LineJoinRound
+	LineJoinBevel
+)
+
+// StrokeStyle describes how a path is stroked.
+type StrokeStyle struct {
+	Width float64
+	Cap   LineCap
+	Join  LineJoin
+}
+
+type drawOp int
+
+const (
+	saveOp drawOp = iota
+	restoreOp
+	concatOp
+	clipOp
+	fillOp
+	strokeOp
+	textOp
+	imageOp
+)
+
+type drawCommand struct {
+	op        drawOp
+	transform layout.Transform
+	path      *Path
+	color     color.Color
+	stroke    StrokeStyle
+	text      string
+	font      text.Font
+	point     layout.Point
+	image     image.Image
+	rect      layout.Rect
+}
+
+// Drawing is a list of drawing commands recorded by a Canvas.
+type Drawing struct {
+	commands []drawCommand
+}
+
+// MarshalProtobuf serializes d into a protobuf object.
+func (d *Drawing) MarshalProtobuf() *paint.Drawing {
+	if d == nil {
+		return nil
+	}
+	commands := make([]*paint.DrawCommand, 0, len(d.commands))
+	for _, i := range d.commands {
+		c := &paint.DrawCommand{Op: paint.DrawOp(i.op)}
+		switch i.op {
+		case concatOp:
+			c.Transform = i.transform.MarshalProtobuf()
+		case clipOp:
+			c.Path = i.path.MarshalProtobuf()
+		case fillOp:
+			c.Path = i.path.MarshalProtobuf()
+			c.Color = pb.ColorEncode(i.color)
+		case strokeOp:
+			c.Path = i.path.MarshalProtobuf()
+			c.Color = pb.ColorEncode(i.color)
+			c.LineWidth = i.stroke.Width
+			c.LineCap = paint.LineCap(i.stroke.Cap)
+			c.LineJoin = paint.LineJoin(i.stroke.Join)
+		case textOp:
+			c.Text = i.text
+			c.Font = i.font.MarshalProtobuf()
+			c.Point = i.point.MarshalProtobuf()
+			c.Color = pb.ColorEncode(i.color)
+		case imageOp:
+			c.Image = pb.ImageEncode(i.image)
+			c.Rect = i.rect.MarshalProtobuf()
+		}
+		commands = append(commands, c)
+	}
+	return &paint.Drawing{Commands: commands}
+}
+
+// UnmarshalProtobuf deserializes d from a protobuf object.
+func (d *Drawing) UnmarshalProtobuf(pbd *paint.Drawing) {
+	d.commands = make([]drawCommand, 0, len(pbd.Commands))
+	for _, i := range pbd.Commands {
+		c := drawCommand{
+			op:     drawOp(i.Op),
+			color:  pb.ColorDecode(i.Color),
+			stroke: StrokeStyle{Width: i.LineWidth, Cap: LineCap(i.LineCap), Join: LineJoin(i.LineJoin)},
+			text:   i.Text,
+		}
+		if i.Transform != nil {
+			c.transform.UnmarshalProtobuf(i.Transform)
+		}
+		if i.Path != nil {
+			c.path = &Path{}
+			c.path.UnmarshalProtobuf(i.Path)
+		}
+		if i.Font != nil {
+			c.font = text.Font{Family: i.Font.Family, Face: i.Font.Face, Size: i.Font.Size}
+		}
+		if i.Point != nil {
+			c.point.UnmarshalProtobuf(i.Point)
+		}
+		if i.Image != nil {
+			c.image = pb.ImageDecode(i.Image)
+		}
+		if i.Rect != nil {
+			c.rect.UnmarshalProtobuf(i.Rect)
+		}
+		d.commands = append(d.commands, c)
+	}
+}
+
+// Canvas records drawing commands into a Drawing. The origin is the top left corner of the view, and the y axis points
+// down.
+type Canvas struct {
+	size     layout.Point
+	commands []drawCommand
+}
+
+// NewCanvas returns a new canvas with the given size.
+func NewCanvas(size layout.Point) *Canvas {
+	return &Canvas{size: size}
+}
+
+// Size returns the size of the view that c draws into.
+func (c *Canvas) Size() layout.Point {
+	return c.size
+}
+
+// Bounds returns a rectangle at the origin with the size of c.
+func (c *Canvas) Bounds() layout.Rect {
+	return layout.Rt(0, 0, c.size.X, c.size.Y)
+}
+
+// Save pushes the current transform and clip onto a stack.
+func (c *Canvas) Save() {
+	c.commands = append(c.commands, drawCommand{op: saveOp})
+}
+
+// Restore pops the transform and clip from the top of the stack.
+func (c *Canvas) Restore() {
+	c.commands = append(c.commands, drawCommand{op: restoreOp})
+}
+
+// Concat applies t to subsequent drawing commands, before the current transform. The anchor point of t is ignored.
+func (c *Canvas) Concat(t layout.Transform) {
+	c.commands = append(c.commands, drawCommand{op: concatOp, transform: t})
+}
+
+// Translate moves the origin of subsequent drawing commands by x and y.
+func (c *Canvas) Translate(x, y float64) {
+	c.Concat(layout.Transform{}.Translate(x, y, 0))
+}
+
+// Scale scales subsequent drawing commands by x and y.
+func (c *Canvas) Scale(x, y float64) {
+	c.Concat(layout.Transform{}.Scale(x, y, 1))
+}
+
+// Rotate rotates subsequent drawing commands clockwise around the origin by angle radians.
+func (c *Canvas) Rotate(angle float64) {
+	c.Concat(layout.Transform{}.Rotate(angle, 0, 0, 1))
+}
+
+// Clip intersects the clipping region with p.
+func (c *Canvas) Clip(p *Path) {
+	c.commands = append(c.commands, drawCommand{op: clipOp, path: p.copy()})
+}
+
+// Fill fills p with col.
+func (c *Canvas) Fill(p *Path, col color.Color) {
+	c.commands = append(c.commands, drawCommand{op: fillOp, path: p.copy(), color: col})
+}
+
+// FillRect fills r with col.
+func (c *Canvas) FillRect(r layout.Rect, col color.Color) {
+	p := &Path{}
+	p.AddRect(r)
+	c.Fill(p, col)
+}
+
+// Stroke draws the outline of p with col.
+func (c *Canvas) Stroke(p *Path, col color.Color, s StrokeStyle) {
+	c.commands = append(c.commands, drawCommand{op: strokeOp, path: p.copy(), color: col, stroke: s})
+}
+
+// FillText draws str on a single line, with the left end of its baseline at origin.
+func (c *Canvas) FillText(str string, origin layout.Point, font text.Font, col color.Color) {
+	c.commands = append(c.commands, drawCommand{op: textOp, text: str, point: origin, font: font, color: col})
+}
+
+// DrawImage draws img scaled to fill r.
+func (c *Canvas) DrawImage(img image.Image, r layout.Rect) {
+	c.commands = append(c.commands, drawCommand{op: imageOp, image: img, rect: r})
+}
+
+// Drawing returns the commands that have been recorded by c.
+func (c *Canvas) Drawing() *Drawing {
+	return &Drawing{commands: append([]drawCommand(nil), c.commands...)}
+}
+
+func (p *Path) copy() *Path {
+	n := *p
+	n.elements = append([]pathElement(nil), p.elements...)
+	return &n
+}
+
+// Drawer is implemented by Painters that draw custom content. During the paint phase, and when the view is resized,
+// DrawCanvas is called with a canvas the size of the view. The recorded drawing replaces Style.Drawing.
+type Drawer interface {
+	Painter
+	DrawCanvas(c *Canvas)
+}
+
+// CanvasPainter is a Drawer that draws with a function. The view is redrawn when any of its Notifiers fire.
+//
+//	progress := &animate.Value{}
+//	painter := &paint.CanvasPainter{
+//		Draw: func(c *paint.Canvas) {
+//			p := &paint.Path{}
+//			p.Arc(c.Bounds().Center(), 20, 0, 2*math.Pi*progress.Value())
+//			c.Stroke(p, colornames.Blue, paint.StrokeStyle{Width: 4, Cap: paint.LineCapRound})
+//		},
+//		Notifiers: []comm.Notifier{progress},
+//	}
+type CanvasPainter struct {
+	Style     Style
+	Draw      func(c *Canvas)
+	Notifiers []comm.Notifier
+
+	maxId          comm.Id
+	groupNotifiers map[comm.Id]notifier
+}
+
+// PaintStyle implements the Painter interface.
+func (p *CanvasPainter) PaintStyle() Style {
+	return p.Style
+}
+
+// DrawCanvas implements the Drawer interface.
+func (p *CanvasPainter) DrawCanvas(c *Canvas) {
+	if p.Draw != nil {
+		p.Draw(c)
+	}
+}
+
+// Notify implements the Painter interface.
+func (p *CanvasPainter) Notify(f func()) comm.Id {
+	n := &comm.Relay{}
+	for _, i := range p.Notifiers {
+		n.Subscribe(i)
+	}
+
+	p.maxId += 1
+	if p.groupNotifiers == nil {
+		p.groupNotifiers = map[comm.Id]notifier{}
+	}
+	p.groupNotifiers[p.maxId] = notifier{
+		notifier: n,
+		id:       n.Notify(f),
+	}
+	return p.maxId
+}
+
+// Unnotify implements the Painter interface.
+func (p *CanvasPainter) Unnotify(id comm.Id) {
+	n, ok := p.groupNotifiers[id]
+	if ok {
+		n.notifier.Unnotify(n.id)
+		delete(p.groupNotifiers, id)
+	}
+}
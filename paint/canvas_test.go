@@ -0,0 +1,176 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/text"
+)
+
+func TestPathArc(t *testing.T) {
+	p := &Path{}
+	p.Arc(layout.Pt(10, 10), 10, 0, math.Pi)
+	if len(p.elements) != 3 {
+		t.Fatalf("Expected a move and two curves: %v", p.elements)
+	}
+	if p.elements[0].op != moveOp || p.elements[0].points[0] != layout.Pt(20, 10) {
+		t.Errorf("Incorrect start: %v", p.elements[0])
+	}
+	if c := p.Current(); math.Abs(c.X) > 1e-9 || math.Abs(c.Y-10) > 1e-9 {
+		t.Errorf("Incorrect end: %v", c)
+	}
+	// The midpoint of the first quarter turn is on the circle, below and to the right of the center.
+	mid := bezier(0.5, p.elements[0].points[0], p.elements[1].points[0], p.elements[1].points[1], p.elements[1].points[2])
+	if d := mid.Distance(layout.Pt(10, 10)); math.Abs(d-10) > 0.01 || mid.X < 10 || mid.Y < 10 {
+		t.Errorf("Incorrect midpoint: %v", mid)
+	}
+}
+
+func TestCanvasFill(t *testing.T) {
+	c := NewCanvas(layout.Pt(20, 20))
+	c.FillRect(layout.Rt(0, 0, 20, 20), blue)
+	c.Save()
+	c.Translate(10, 0)
+	c.FillRect(layout.Rt(0, 0, 10, 10), red)
+	c.Restore()
+	c.FillRect(layout.Rt(0, 10, 5, 20), red)
+	img := c.Drawing().Rasterize(20, 20)
+
+	for _, i := range []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		{2, 2, blue},
+		{15, 5, red},
+		{2, 15, red},
+		{15, 15, blue},
+	} {
+		if c := img.RGBAAt(i.x, i.y); c != i.color {
+			t.Errorf("Pixel (%v, %v) = %v, expected %v", i.x, i.y, c, i.color)
+		}
+	}
+}
+
+func TestCanvasClip(t *testing.T) {
+	c := NewCanvas(layout.Pt(20, 20))
+	clip := &Path{}
+	clip.AddEllipse(layout.Rt(0, 0, 20, 20))
+	c.Clip(clip)
+	c.FillRect(layout.Rt(0, 0, 20, 20), red)
+	img := c.Drawing().Rasterize(20, 20)
+
+	if c := img.RGBAAt(10, 10); c != red {
+		t.Errorf("Incorrect center: %v", c)
+	}
+	if c := img.RGBAAt(0, 0); c != (color.RGBA{}) {
+		t.Errorf("Incorrect corner: %v", c)
+	}
+}
+
+func TestCanvasStroke(t *testing.T) {
+	p := &Path{}
+	p.MoveTo(layout.Pt(5, 10))
+	p.LineTo(layout.Pt(15, 10))
+	p.LineTo(layout.Pt(15, 20))
+
+	for _, i := range []struct {
+		style StrokeStyle
+		x, y  int
+		color color.RGBA
+	}{
+		{StrokeStyle{Width: 4}, 10, 9, red},
+		{StrokeStyle{Width: 4}, 10, 13, color.RGBA{}},
+		// Butt caps end at the end point, and square caps extend past it.
+		{StrokeStyle{Width: 4}, 3, 10, color.RGBA{}},
+		{StrokeStyle{Width: 4, Cap: LineCapSquare}, 3, 10, red},
+		// Miter joins fill the outer corner, and bevel joins cut it off.
+		{StrokeStyle{Width: 4}, 16, 8, red},
+		{StrokeStyle{Width: 4, Join: LineJoinBevel}, 16, 8, color.RGBA{}},
+	} {
+		c := NewCanvas(layout.Pt(20, 20))
+		c.Stroke(p, red, i.style)
+		img := c.Drawing().Rasterize(20, 20)
+		if c := img.RGBAAt(i.x, i.y); c != i.color {
+			t.Errorf("%+v: Pixel (%v, %v) = %v, expected %v", i.style, i.x, i.y, c, i.color)
+		}
+	}
+}
+
+func TestCanvasImage(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 2; y++ {
+			src.Set(x, y, blue)
+		}
+	}
+	c := NewCanvas(layout.Pt(20, 20))
+	c.Rotate(math.Pi / 2)
+	c.DrawImage(src, layout.Rt(0, -10, 10, 0))
+	img := c.Drawing().Rasterize(20, 20)
+
+	if c := img.RGBAAt(5, 5); c != blue {
+		t.Errorf("Incorrect image: %v", c)
+	}
+	if c := img.RGBAAt(15, 5); c != (color.RGBA{}) {
+		t.Errorf("Incorrect background: %v", c)
+	}
+}
+
+func TestDrawingProtobuf(t *testing.T) {
+	p := &Path{}
+	p.MoveTo(layout.Pt(1, 2))
+	p.QuadTo(layout.Pt(3, 4), layout.Pt(5, 6))
+	p.CubicTo(layout.Pt(7, 8), layout.Pt(9, 10), layout.Pt(11, 12))
+	p.Close()
+
+	c := NewCanvas(layout.Pt(20, 20))
+	c.Save()
+	c.Scale(2, 3)
+	c.Clip(p)
+	c.Stroke(p, red, StrokeStyle{Width: 2, Cap: LineCapRound, Join: LineJoinBevel})
+	c.Restore()
+	c.FillText("matcha", layout.Pt(1, 15), text.Font{Family: "Helvetica", Size: 12}, blue)
+	c.DrawImage(image.NewRGBA(image.Rect(0, 0, 1, 1)), layout.Rt(0, 0, 1, 1))
+
+	d := &Drawing{}
+	d.UnmarshalProtobuf(c.Drawing().MarshalProtobuf())
+	if !proto.Equal(d.MarshalProtobuf(), c.Drawing().MarshalProtobuf()) {
+		t.Errorf("Incorrect round trip: %v", d.MarshalProtobuf())
+	}
+	if (*Drawing)(nil).MarshalProtobuf() != nil {
+		t.Error("Expected nil")
+	}
+}
+
+func TestCanvasPainter(t *testing.T) {
+	n := &colorNotifier{color: red}
+	p := &CanvasPainter{
+		Draw: func(c *Canvas) {
+			c.FillRect(c.Bounds(), n.Value())
+		},
+		Notifiers: []comm.Notifier{n},
+	}
+
+	count := 0
+	id := p.Notify(func() { count += 1 })
+	n.Signal()
+	if count != 1 {
+		t.Errorf("Expected notification")
+	}
+	p.Unnotify(id)
+	n.Signal()
+	if count != 1 {
+		t.Errorf("Unexpected notification")
+	}
+
+	c := NewCanvas(layout.Pt(4, 4))
+	p.DrawCanvas(c)
+	if px := c.Drawing().Rasterize(4, 4).RGBAAt(1, 1); px != red {
+		t.Errorf("Incorrect drawing: %v", px)
+	}
+}
@@ -0,0 +1,286 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/pb"
+	"gomatcha.io/matcha/pb/paint"
+)
+
+// GradientKind is the shape of a gradient.
+type GradientKind int
+
+const (
+	// LinearGradient varies color along a line through the center of the view.
+	LinearGradient GradientKind = iota
+	// RadialGradient varies color with the distance from a center point.
+	RadialGradient
+)
+
+// MarshalProtobuf serializes k into a protobuf object.
+func (k GradientKind) MarshalProtobuf() paint.GradientKind {
+	return paint.GradientKind(k)
+}
+
+// GradientStop is a color at a location along a gradient.
+type GradientStop struct {
+	// Location is the position of the stop, from 0 at the start of the gradient to 1 at the end.
+	Location float64
+	Color    color.Color
+}
+
+// Gradient is a fill that smoothly transitions between colors. Colors are interpolated in premultiplied RGBA. Before
+// the first stop and after the last stop, the color of the nearest stop is used.
+//
+//	style := &paint.Style{
+//		BackgroundGradient: &paint.Gradient{
+//			Angle: math.Pi / 2, // top to bottom
+//			Stops: []paint.GradientStop{
+//				{Location: 0, Color: colornames.Red},
+//				{Location: 1, Color: colornames.Blue},
+//			},
+//		},
+//	}
+type Gradient struct {
+	Kind  GradientKind
+	Stops []GradientStop
+	// Angle is the direction of a linear gradient in radians, clockwise from left to right. Like CSS, the gradient line
+	// passes through the center of the view, and is long enough that the corners are at the first and last stops.
+	Angle float64
+	// Center is the center of a radial gradient in unit coordinates of the view. (0, 0) is the top left corner and
+	// (1, 1) is the bottom right corner.
+	Center layout.Point
+	// Radius is the radius of a radial gradient in points. If it is 0, the radius is the distance from Center to the
+	// farthest corner of the view.
+	Radius float64
+}
+
+// MarshalProtobuf serializes g into a protobuf object.
+func (g *Gradient) MarshalProtobuf() *paint.Gradient {
+	if g == nil {
+		return nil
+	}
+	stops := make([]*paint.GradientStop, 0, len(g.Stops))
+	for _, i := range g.Stops {
+		stops = append(stops, &paint.GradientStop{
+			Location: i.Location,
+			Color:    pb.ColorEncode(i.Color),
+		})
+	}
+	return &paint.Gradient{
+		Kind:   g.Kind.MarshalProtobuf(),
+		Stops:  stops,
+		Angle:  g.Angle,
+		Center: g.Center.MarshalProtobuf(),
+		Radius: g.Radius,
+	}
+}
+
+// UnmarshalProtobuf deserializes g from a protobuf object.
+func (g *Gradient) UnmarshalProtobuf(pbg *paint.Gradient) {
+	g.Kind = GradientKind(pbg.Kind)
+	g.Stops = make([]GradientStop, 0, len(pbg.Stops))
+	for _, i := range pbg.Stops {
+		g.Stops = append(g.Stops, GradientStop{Location: i.Location, Color: pb.ColorDecode(i.Color)})
+	}
+	g.Angle = pbg.Angle
+	g.Center = layout.Point{}
+	if pbg.Center != nil {
+		g.Center.UnmarshalProtobuf(pbg.Center)
+	}
+	g.Radius = pbg.Radius
+}
+
+// Location returns the position of p along g, in a view with the given frame. 0 is the start of the gradient and 1
+// is the end.
+func (g *Gradient) Location(p layout.Point, frame layout.Rect) float64 {
+	w, h := frame.Width(), frame.Height()
+	switch g.Kind {
+	case RadialGradient:
+		c := layout.Pt(frame.Min.X+g.Center.X*w, frame.Min.Y+g.Center.Y*h)
+		r := g.Radius
+		if r == 0 {
+			for _, i := range []layout.Point{frame.Min, frame.Max, layout.Pt(frame.Min.X, frame.Max.Y), layout.Pt(frame.Max.X, frame.Min.Y)} {
+				r = math.Max(r, c.Distance(i))
+			}
+		}
+		if r == 0 {
+			return 0
+		}
+		return p.Distance(c) / r
+	default:
+		dir := layout.Pt(math.Cos(g.Angle), math.Sin(g.Angle))
+		length := math.Abs(w*dir.X) + math.Abs(h*dir.Y)
+		if length == 0 {
+			return 0
+		}
+		return p.Sub(frame.Center()).Dot(dir)/length + 0.5
+	}
+}
+
+// ColorAt returns the color of g at location t.
+func (g *Gradient) ColorAt(t float64) color.Color {
+	if len(g.Stops) == 0 {
+		return color.Transparent
+	}
+	// Like CSS, a stop before the previous stop is moved to the previous stop.
+	prev := g.Stops[0]
+	if t <= prev.Location {
+		return nonNilColor(prev.Color)
+	}
+	for _, i := range g.Stops[1:] {
+		if i.Location < prev.Location {
+			i.Location = prev.Location
+		}
+		if t < i.Location {
+			return lerpColor(prev.Color, i.Color, (t-prev.Location)/(i.Location-prev.Location))
+		}
+		prev = i
+	}
+	return nonNilColor(prev.Color)
+}
+
+// Rasterize draws g into a new image with the given size, sampling the center of each pixel. It is a reference
+// implementation of how gradients are displayed on device.
+func (g *Gradient) Rasterize(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	g.Draw(img, img.Bounds())
+	return img
+}
+
+// Draw draws g into the rectangle r of dst.
+func (g *Gradient) Draw(dst *image.RGBA, r image.Rectangle) {
+	frame := layout.Rt(float64(r.Min.X), float64(r.Min.Y), float64(r.Max.X), float64(r.Max.Y))
+	r = r.Intersect(dst.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			t := g.Location(layout.Pt(float64(x)+0.5, float64(y)+0.5), frame)
+			dst.Set(x, y, g.ColorAt(t))
+		}
+	}
+}
+
+func nonNilColor(c color.Color) color.Color {
+	if c == nil {
+		return color.Transparent
+	}
+	return c
+}
+
+// lerpColor interpolates between premultiplied colors. A nil color is transparent.
+func lerpColor(a, b color.Color, r float64) color.Color {
+	r1, g1, b1, a1 := nonNilColor(a).RGBA()
+	r2, g2, b2, a2 := nonNilColor(b).RGBA()
+	c := func(x, y uint32) uint16 {
+		return uint16(float64(x) + (float64(y)-float64(x))*r + 0.5)
+	}
+	return color.RGBA64{R: c(r1, r2), G: c(g1, g2), B: c(b1, b2), A: c(a1, a2)}
+}
+
+// GradientNotifier wraps the comm.Notifier interface with an additional Value() method which returns a Gradient.
+type GradientNotifier interface {
+	comm.Notifier
+	Value() *Gradient
+}
+
+// AnimatedGradient is a GradientNotifier that combines animated values with a Gradient. StopColors and StopLocations
+// replace the color and location of the stop with the same index, and are ignored if they are nil.
+//
+//	value := &animate.Value{}
+//	style := &paint.AnimatedStyle{
+//		BackgroundGradient: &paint.AnimatedGradient{
+//			Gradient: paint.Gradient{Stops: []paint.GradientStop{
+//				{Location: 0, Color: colornames.Red},
+//				{Location: 1, Color: colornames.Blue},
+//			}},
+//			StopColors: []comm.ColorNotifier{
+//				animate.RGBALerp{Start: colornames.Red, End: colornames.Yellow}.Notifier(value),
+//			},
+//		},
+//	}
+//	value.Run(&animate.Basic{End: 1, Dur: time.Second})
+type AnimatedGradient struct {
+	Gradient      Gradient
+	StopColors    []comm.ColorNotifier
+	StopLocations []comm.Float64Notifier
+	Angle         comm.Float64Notifier
+	Center        layout.PointNotifier
+	Radius        comm.Float64Notifier
+
+	maxId          comm.Id
+	groupNotifiers map[comm.Id]notifier
+}
+
+// Value implements the GradientNotifier interface.
+func (ag *AnimatedGradient) Value() *Gradient {
+	g := ag.Gradient
+	g.Stops = append([]GradientStop(nil), ag.Gradient.Stops...)
+	for idx, i := range ag.StopColors {
+		if i != nil && idx < len(g.Stops) {
+			g.Stops[idx].Color = i.Value()
+		}
+	}
+	for idx, i := range ag.StopLocations {
+		if i != nil && idx < len(g.Stops) {
+			g.Stops[idx].Location = i.Value()
+		}
+	}
+	if ag.Angle != nil {
+		g.Angle = ag.Angle.Value()
+	}
+	if ag.Center != nil {
+		g.Center = ag.Center.Value()
+	}
+	if ag.Radius != nil {
+		g.Radius = ag.Radius.Value()
+	}
+	return &g
+}
+
+// Notify implements the GradientNotifier interface.
+func (ag *AnimatedGradient) Notify(f func()) comm.Id {
+	n := &comm.Relay{}
+
+	for _, i := range ag.StopColors {
+		if i != nil {
+			n.Subscribe(i)
+		}
+	}
+	for _, i := range ag.StopLocations {
+		if i != nil {
+			n.Subscribe(i)
+		}
+	}
+	if ag.Angle != nil {
+		n.Subscribe(ag.Angle)
+	}
+	if ag.Center != nil {
+		n.Subscribe(ag.Center)
+	}
+	if ag.Radius != nil {
+		n.Subscribe(ag.Radius)
+	}
+
+	ag.maxId += 1
+	if ag.groupNotifiers == nil {
+		ag.groupNotifiers = map[comm.Id]notifier{}
+	}
+	ag.groupNotifiers[ag.maxId] = notifier{
+		notifier: n,
+		id:       n.Notify(f),
+	}
+	return ag.maxId
+}
+
+// Unnotify implements the GradientNotifier interface.
+func (ag *AnimatedGradient) Unnotify(id comm.Id) {
+	n, ok := ag.groupNotifiers[id]
+	if ok {
+		n.notifier.Unnotify(n.id)
+		delete(ag.groupNotifiers, id)
+	}
+}
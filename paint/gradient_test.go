@@ -0,0 +1,133 @@
+package paint
+
+import (
+	"image/color"
+	"math"
+	"reflect"
+	"testing"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+)
+
+var (
+	red  = color.RGBA{0xff, 0, 0, 0xff}
+	blue = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func TestGradientLocation(t *testing.T) {
+	frame := layout.Rt(0, 0, 100, 50)
+	for _, i := range []struct {
+		g        *Gradient
+		p        layout.Point
+		location float64
+	}{
+		{&Gradient{}, layout.Pt(0, 10), 0},
+		{&Gradient{}, layout.Pt(25, 10), 0.25},
+		{&Gradient{Angle: math.Pi / 2}, layout.Pt(10, 50), 1},
+		{&Gradient{Angle: math.Pi}, layout.Pt(25, 10), 0.75},
+		// The corners of a diagonal gradient are at the start and end.
+		{&Gradient{Angle: math.Pi / 4}, layout.Pt(100, 50), 1},
+		{&Gradient{Kind: RadialGradient, Center: layout.Pt(0.5, 0.5), Radius: 20}, layout.Pt(60, 25), 0.5},
+		{&Gradient{Kind: RadialGradient}, layout.Pt(100, 50), 1},
+	} {
+		if l := i.g.Location(i.p, frame); math.Abs(l-i.location) > 1e-9 {
+			t.Errorf("%+v: Location(%v) = %v, expected %v", i.g, i.p, l, i.location)
+		}
+	}
+}
+
+func TestGradientColorAt(t *testing.T) {
+	g := &Gradient{Stops: []GradientStop{{Location: 0.2, Color: red}, {Location: 0.6, Color: blue}, {Location: 0.4, Color: red}}}
+	for _, i := range []struct {
+		location float64
+		color    color.Color
+	}{
+		{0, red},
+		{0.4, color.RGBA64{0x7fff, 0, 0x8000, 0xffff}},
+		// The last stop is moved to the location of the previous stop.
+		{0.7, red},
+	} {
+		if c := g.ColorAt(i.location); !sameColor(c, i.color) {
+			t.Errorf("ColorAt(%v) = %v, expected %v", i.location, c, i.color)
+		}
+	}
+	if c := (&Gradient{}).ColorAt(0.5); c != color.Transparent {
+		t.Errorf("Incorrect color: %v", c)
+	}
+}
+
+func TestGradientRasterize(t *testing.T) {
+	g := &Gradient{Angle: math.Pi / 2, Stops: []GradientStop{{Location: 0, Color: red}, {Location: 1, Color: blue}}}
+	img := g.Rasterize(4, 4)
+	for _, i := range []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		{0, 0, color.RGBA{0xdf, 0, 0x20, 0xff}},
+		{3, 0, color.RGBA{0xdf, 0, 0x20, 0xff}},
+		{0, 3, color.RGBA{0x20, 0, 0xdf, 0xff}},
+	} {
+		if c := img.RGBAAt(i.x, i.y); c != i.color {
+			t.Errorf("Pixel (%v, %v) = %v, expected %v", i.x, i.y, c, i.color)
+		}
+	}
+}
+
+func TestGradientProtobuf(t *testing.T) {
+	g := &Gradient{
+		Kind:   RadialGradient,
+		Stops:  []GradientStop{{Location: 0, Color: color.RGBA64{1, 2, 3, 4}}, {Location: 1}},
+		Angle:  1,
+		Center: layout.Pt(0.5, 0.25),
+		Radius: 10,
+	}
+	g2 := &Gradient{}
+	g2.UnmarshalProtobuf(g.MarshalProtobuf())
+	if !reflect.DeepEqual(g, g2) {
+		t.Errorf("Incorrect round trip: %+v", g2)
+	}
+	if (*Gradient)(nil).MarshalProtobuf() != nil {
+		t.Error("Expected nil")
+	}
+}
+
+type colorNotifier struct {
+	comm.Relay
+	color color.Color
+}
+
+func (n *colorNotifier) Value() color.Color {
+	return n.color
+}
+
+func TestAnimatedGradient(t *testing.T) {
+	stop := &colorNotifier{color: blue}
+	ag := &AnimatedGradient{
+		Gradient:   Gradient{Stops: []GradientStop{{Location: 0, Color: red}, {Location: 1, Color: red}}},
+		StopColors: []comm.ColorNotifier{nil, stop},
+	}
+	as := &AnimatedStyle{BackgroundGradient: ag}
+
+	count := 0
+	id := as.Notify(func() { count += 1 })
+	stop.Signal()
+	if count != 1 {
+		t.Errorf("Expected notification")
+	}
+	as.Unnotify(id)
+
+	s := as.PaintStyle()
+	if s.BackgroundGradient.Stops[0].Color != red || s.BackgroundGradient.Stops[1].Color != blue {
+		t.Errorf("Incorrect stops: %v", s.BackgroundGradient.Stops)
+	}
+	if ag.Gradient.Stops[1].Color != red {
+		t.Errorf("Gradient was modified")
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
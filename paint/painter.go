@@ -15,6 +15,7 @@ import (
 	"gomatcha.io/matcha/comm"
 	"gomatcha.io/matcha/layout"
 	"gomatcha.io/matcha/pb"
+	pblayout "gomatcha.io/matcha/pb/layout"
 	"gomatcha.io/matcha/pb/paint"
 )
 
@@ -25,30 +26,113 @@ type Painter interface {
 }
 
 // Style is a list of display properties of that views can set.
+//
+// The iOS client does not apply Transform, BackgroundGradient, Drawing, CornerRadii, ClipsChildren, Borders or Mask
+// yet. They are encoded for native clients, but are currently only rendered by the snapshot package.
 type Style struct {
 	Transparency    float64
 	BackgroundColor color.Color
-	BorderColor     color.Color
-	BorderWidth     float64
-	CornerRadius    float64
-	ShadowRadius    float64
-	ShadowOffset    layout.Point
-	ShadowColor     color.Color
+	// BackgroundGradient is drawn above BackgroundColor.
+	BackgroundGradient *Gradient
+	BorderColor        color.Color
+	BorderWidth        float64
+	// Borders replaces BorderColor and BorderWidth if it is not nil.
+	Borders      *Borders
+	CornerRadius float64
+	// CornerRadii replaces CornerRadius if any of its radii are not 0.
+	CornerRadii CornerRadii
+	// ClipsChildren hides the parts of children that are outside of the rounded bounds of the view.
+	ClipsChildren bool
+	// Mask hides parts of the view and its children.
+	Mask         *Mask
+	ShadowRadius float64
+	ShadowOffset layout.Point
+	ShadowColor  color.Color
+	// Transform is applied to the view after the transform of its layout guide.
+	Transform layout.Transform
+	// Drawing is drawn above the background, in the coordinate space of the view. It is set by Drawers.
+	Drawing *Drawing
 }
 
 func (s *Style) MarshalProtobuf() *paint.Style {
+	var transform *pblayout.Transform
+	if s.Transform != (layout.Transform{}) {
+		transform = s.Transform.MarshalProtobuf()
+	}
 	return &paint.Style{
-		Transparency:    s.Transparency,
-		BackgroundColor: pb.ColorEncode(s.BackgroundColor),
-		BorderColor:     pb.ColorEncode(s.BorderColor),
-		BorderWidth:     s.BorderWidth,
-		CornerRadius:    s.CornerRadius,
-		ShadowRadius:    s.ShadowRadius,
-		ShadowOffset:    s.ShadowOffset.MarshalProtobuf(),
-		ShadowColor:     pb.ColorEncode(s.ShadowColor),
+		Transparency:       s.Transparency,
+		BackgroundColor:    pb.ColorEncode(s.BackgroundColor),
+		BorderColor:        pb.ColorEncode(s.BorderColor),
+		BorderWidth:        s.BorderWidth,
+		CornerRadius:       s.CornerRadius,
+		ShadowRadius:       s.ShadowRadius,
+		ShadowOffset:       s.ShadowOffset.MarshalProtobuf(),
+		ShadowColor:        pb.ColorEncode(s.ShadowColor),
+		Transform:          transform,
+		BackgroundGradient: s.BackgroundGradient.MarshalProtobuf(),
+		Drawing:            s.Drawing.MarshalProtobuf(),
+		CornerRadii:        s.CornerRadii.MarshalProtobuf(),
+		ClipsChildren:      s.ClipsChildren,
+		Borders:            s.Borders.MarshalProtobuf(),
+		Mask:               s.Mask.MarshalProtobuf(),
 	}
 }
 
+// UnmarshalProtobuf deserializes s from a protobuf object.
+func (s *Style) UnmarshalProtobuf(pbs *paint.Style) {
+	*s = Style{
+		Transparency:    pbs.Transparency,
+		BackgroundColor: pb.ColorDecode(pbs.BackgroundColor),
+		BorderColor:     pb.ColorDecode(pbs.BorderColor),
+		BorderWidth:     pbs.BorderWidth,
+		CornerRadius:    pbs.CornerRadius,
+		ClipsChildren:   pbs.ClipsChildren,
+		ShadowRadius:    pbs.ShadowRadius,
+		ShadowColor:     pb.ColorDecode(pbs.ShadowColor),
+	}
+	if pbs.ShadowOffset != nil {
+		s.ShadowOffset.UnmarshalProtobuf(pbs.ShadowOffset)
+	}
+	if pbs.Transform != nil {
+		s.Transform.UnmarshalProtobuf(pbs.Transform)
+	}
+	if pbs.BackgroundGradient != nil {
+		s.BackgroundGradient = &Gradient{}
+		s.BackgroundGradient.UnmarshalProtobuf(pbs.BackgroundGradient)
+	}
+	if pbs.Borders != nil {
+		s.Borders = &Borders{}
+		s.Borders.UnmarshalProtobuf(pbs.Borders)
+	}
+	if pbs.CornerRadii != nil {
+		s.CornerRadii.UnmarshalProtobuf(pbs.CornerRadii)
+	}
+	if pbs.Mask != nil {
+		s.Mask = &Mask{}
+		s.Mask.UnmarshalProtobuf(pbs.Mask)
+	}
+	if pbs.Drawing != nil {
+		s.Drawing = &Drawing{}
+		s.Drawing.UnmarshalProtobuf(pbs.Drawing)
+	}
+}
+
+// Radii returns the radius of each corner of s, from CornerRadii or CornerRadius.
+func (s *Style) Radii() CornerRadii {
+	if !s.CornerRadii.IsZero() {
+		return s.CornerRadii
+	}
+	return Radii(CornerAll, s.CornerRadius)
+}
+
+// EdgeBorders returns the border of each edge of s, from Borders or BorderColor and BorderWidth.
+func (s *Style) EdgeBorders() Borders {
+	if s.Borders != nil {
+		return *s.Borders
+	}
+	return *UniformBorders(Border{Color: s.BorderColor, Width: s.BorderWidth})
+}
+
 // PaintStyle implements the Painter interface.
 func (s *Style) PaintStyle() Style {
 	if s == nil {
@@ -77,12 +161,19 @@ type AnimatedStyle struct {
 	Style           Style
 	Transparency    comm.Float64Notifier
 	BackgroundColor comm.ColorNotifier
-	BorderColor     comm.ColorNotifier
-	BorderWidth     comm.Float64Notifier
-	CornerRadius    comm.Float64Notifier
-	ShadowRadius    comm.Float64Notifier
-	ShadowOffset    layout.PointNotifier
-	ShadowColor     comm.ColorNotifier
+	// BackgroundGradient replaces Style.BackgroundGradient.
+	BackgroundGradient GradientNotifier
+	BorderColor        comm.ColorNotifier
+	BorderWidth        comm.Float64Notifier
+	// Borders replaces Style.Borders.
+	Borders      BordersNotifier
+	CornerRadius comm.Float64Notifier
+	// CornerRadii replaces Style.CornerRadii.
+	CornerRadii  CornerRadiiNotifier
+	ShadowRadius comm.Float64Notifier
+	ShadowOffset layout.PointNotifier
+	ShadowColor  comm.ColorNotifier
+	Transform    layout.TransformNotifier
 
 	maxId          comm.Id
 	groupNotifiers map[comm.Id]notifier
@@ -97,15 +188,24 @@ func (as *AnimatedStyle) PaintStyle() Style {
 	if as.BackgroundColor != nil {
 		s.BackgroundColor = as.BackgroundColor.Value()
 	}
+	if as.BackgroundGradient != nil {
+		s.BackgroundGradient = as.BackgroundGradient.Value()
+	}
 	if as.BorderColor != nil {
 		s.BorderColor = as.BorderColor.Value()
 	}
 	if as.BorderWidth != nil {
 		s.BorderWidth = as.BorderWidth.Value()
 	}
+	if as.Borders != nil {
+		s.Borders = as.Borders.Value()
+	}
 	if as.CornerRadius != nil {
 		s.CornerRadius = as.CornerRadius.Value()
 	}
+	if as.CornerRadii != nil {
+		s.CornerRadii = as.CornerRadii.Value()
+	}
 	if as.ShadowRadius != nil {
 		s.ShadowRadius = as.ShadowRadius.Value()
 	}
@@ -115,6 +215,9 @@ func (as *AnimatedStyle) PaintStyle() Style {
 	if as.ShadowColor != nil {
 		s.ShadowColor = as.ShadowColor.Value()
 	}
+	if as.Transform != nil {
+		s.Transform = as.Transform.Value()
+	}
 	return s
 }
 
@@ -128,15 +231,24 @@ func (as *AnimatedStyle) Notify(f func()) comm.Id {
 	if as.BackgroundColor != nil {
 		n.Subscribe(as.BackgroundColor)
 	}
+	if as.BackgroundGradient != nil {
+		n.Subscribe(as.BackgroundGradient)
+	}
 	if as.BorderColor != nil {
 		n.Subscribe(as.BorderColor)
 	}
 	if as.BorderWidth != nil {
 		n.Subscribe(as.BorderWidth)
 	}
+	if as.Borders != nil {
+		n.Subscribe(as.Borders)
+	}
 	if as.CornerRadius != nil {
 		n.Subscribe(as.CornerRadius)
 	}
+	if as.CornerRadii != nil {
+		n.Subscribe(as.CornerRadii)
+	}
 	if as.ShadowRadius != nil {
 		n.Subscribe(as.ShadowRadius)
 	}
@@ -146,6 +258,9 @@ func (as *AnimatedStyle) Notify(f func()) comm.Id {
 	if as.ShadowColor != nil {
 		n.Subscribe(as.ShadowColor)
 	}
+	if as.Transform != nil {
+		n.Subscribe(as.Transform)
+	}
 
 	as.maxId += 1
 	if as.groupNotifiers == nil {
@@ -0,0 +1,36 @@
+package paint
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"gomatcha.io/matcha/layout"
+)
+
+func TestStyleProtobuf(t *testing.T) {
+	c := NewCanvas(layout.Pt(10, 10))
+	c.FillRect(c.Bounds(), red)
+	s := &Style{
+		Transparency:       0.5,
+		BackgroundColor:    red,
+		BackgroundGradient: &Gradient{Stops: []GradientStop{{Location: 0, Color: blue}}},
+		BorderColor:        blue,
+		BorderWidth:        1,
+		Borders:            UniformBorders(Border{Color: red, Width: 2, Dash: []float64{1, 2}}),
+		CornerRadius:       3,
+		CornerRadii:        Radii(CornerTop, 4),
+		ClipsChildren:      true,
+		Mask:               &Mask{Path: &Path{}},
+		ShadowRadius:       5,
+		ShadowOffset:       layout.Pt(1, 2),
+		ShadowColor:        color.Black,
+		Transform:          layout.Transform{}.Scale(2, 2, 1),
+		Drawing:            c.Drawing(),
+	}
+	s2 := &Style{}
+	s2.UnmarshalProtobuf(s.MarshalProtobuf())
+	if !proto.Equal(s.MarshalProtobuf(), s2.MarshalProtobuf()) {
+		t.Errorf("Incorrect round trip: %+v", s2)
+	}
+}
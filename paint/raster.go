@@ -0,0 +1,375 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/f64"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+	"gomatcha.io/matcha/layout"
+)
+
+// miterLimit is the ratio of miter length to half the line width, past which miter joins are drawn as bevels.
+const miterLimit = 10
+
+// curveSegments is the number of line segments that curves are flattened into.
+const curveSegments = 16
+
+// Rasterize draws d into a new image with the given size. It is a reference implementation of how drawings are
+// displayed on device.
+func (d *Drawing) Rasterize(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	d.Draw(img, layout.Transform{})
+	return img
+}
+
+// Draw draws d into dst, with the coordinates of d mapped to pixels of dst by t. Paths are antialiased and filled with
+// the nonzero winding rule. Text is drawn with a fixed 7x13 bitmap face at the transformed origin, and is not scaled or
+// rotated.
+func (d *Drawing) Draw(dst *image.RGBA, t layout.Transform) {
+	type state struct {
+		ctm  layout.Transform
+		clip *image.Alpha
+	}
+	r := &rasterizer{dst: dst, bounds: dst.Bounds()}
+	cur := state{ctm: t}
+	stack := []state{}
+	for _, c := range d.commands {
+		r.ctm, r.clip = cur.ctm, cur.clip
+		switch c.op {
+		case saveOp:
+			stack = append(stack, cur)
+		case restoreOp:
+			if len(stack) > 0 {
+				cur = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+		case concatOp:
+			cur.ctm = c.transform.Concat(cur.ctm)
+		case clipOp:
+			cur.clip = r.fillMask(c.path)
+		case fillOp:
+			r.draw(r.fillMask(c.path), c.color)
+		case strokeOp:
+			r.draw(r.strokeMask(c.path, c.stroke), c.color)
+		case textOp:
+			r.draw(r.textMask(c.text, c.point), c.color)
+		case imageOp:
+			r.drawImage(c.image, c.rect)
+		}
+	}
+}
+
+// Mask returns the coverage of p, with the coordinates of p mapped to pixels by t, as an antialiased mask with the given
+// bounds.
+func (p *Path) Mask(bounds image.Rectangle, t layout.Transform) *image.Alpha {
+	r := &rasterizer{bounds: bounds, ctm: t}
+	return r.fillMask(p)
+}
+
+type rasterizer struct {
+	dst    *image.RGBA
+	bounds image.Rectangle
+	ctm    layout.Transform
+	clip   *image.Alpha
+}
+
+// newMask returns a mask with the bounds of the rasterizer.
+func (r *rasterizer) newMask() *image.Alpha {
+	return image.NewAlpha(r.bounds)
+}
+
+// rasterize draws the polygons, in pixel coordinates, into a new mask that is intersected with the clip.
+func (r *rasterizer) rasterize(polygons [][]layout.Point) *image.Alpha {
+	b := r.bounds
+	z := vector.NewRasterizer(b.Dx(), b.Dy())
+	for _, i := range polygons {
+		if len(i) < 3 {
+			continue
+		}
+		for idx, j := range i {
+			x, y := float32(j.X-float64(b.Min.X)), float32(j.Y-float64(b.Min.Y))
+			if idx == 0 {
+				z.MoveTo(x, y)
+			} else {
+				z.LineTo(x, y)
+			}
+		}
+		z.ClosePath()
+	}
+	mask := r.newMask()
+	z.Draw(mask, b, image.Opaque, image.Point{})
+	return r.clipMask(mask)
+}
+
+// clipMask intersects mask with the clip.
+func (r *rasterizer) clipMask(mask *image.Alpha) *image.Alpha {
+	if r.clip != nil {
+		for idx, i := range r.clip.Pix {
+			mask.Pix[idx] = uint8(uint16(mask.Pix[idx]) * uint16(i) / 0xff)
+		}
+	}
+	return mask
+}
+
+func (r *rasterizer) draw(mask *image.Alpha, c color.Color) {
+	if c == nil {
+		return
+	}
+	b := r.dst.Bounds()
+	xdraw.DrawMask(r.dst, b, image.NewUniform(c), image.Point{}, mask, b.Min, xdraw.Over)
+}
+
+func (r *rasterizer) fillMask(p *Path) *image.Alpha {
+	polygons := [][]layout.Point{}
+	for _, i := range flatten(p) {
+		polygons = append(polygons, r.apply(i.points))
+	}
+	return r.rasterize(polygons)
+}
+
+func (r *rasterizer) strokeMask(p *Path, s StrokeStyle) *image.Alpha {
+	polygons := [][]layout.Point{}
+	for _, i := range stroke(p, s) {
+		// Outlines overlap, so give them all the same orientation so that their winding numbers never cancel out.
+		i = r.apply(i)
+		if area(i) < 0 {
+			for j, k := 0, len(i)-1; j < k; j, k = j+1, k-1 {
+				i[j], i[k] = i[k], i[j]
+			}
+		}
+		polygons = append(polygons, i)
+	}
+	return r.rasterize(polygons)
+}
+
+func (r *rasterizer) textMask(str string, origin layout.Point) *image.Alpha {
+	mask := r.newMask()
+	pt := r.ctm.Apply(origin)
+	d := &font.Drawer{
+		Dst:  mask,
+		Src:  image.Opaque,
+		Face: basicfont.Face7x13,
+		Dot:  fixed.Point26_6{X: fixed.Int26_6(pt.X * 64), Y: fixed.Int26_6(pt.Y * 64)},
+	}
+	d.DrawString(str)
+	return r.clipMask(mask)
+}
+
+func (r *rasterizer) drawImage(img image.Image, rect layout.Rect) {
+	if img == nil || img.Bounds().Empty() || rect.IsEmpty() {
+		return
+	}
+	// Map source pixels into rect, and then into dst with the current transform.
+	sb := img.Bounds()
+	sx, sy := rect.Width()/float64(sb.Dx()), rect.Height()/float64(sb.Dy())
+	tx, ty := rect.Min.X-float64(sb.Min.X)*sx, rect.Min.Y-float64(sb.Min.Y)*sy
+	m := r.ctm.Matrix()
+	s2d := f64.Aff3{
+		sx * m[0][0], sy * m[1][0], tx*m[0][0] + ty*m[1][0] + m[3][0],
+		sx * m[0][1], sy * m[1][1], tx*m[0][1] + ty*m[1][1] + m[3][1],
+	}
+	opts := &xdraw.Options{}
+	if r.clip != nil {
+		opts.DstMask = r.clip
+		opts.DstMaskP = r.dst.Bounds().Min
+	}
+	xdraw.ApproxBiLinear.Transform(r.dst, s2d, img, sb, xdraw.Over, opts)
+}
+
+func (r *rasterizer) apply(points []layout.Point) []layout.Point {
+	ps := make([]layout.Point, len(points))
+	for idx, i := range points {
+		ps[idx] = r.ctm.Apply(i)
+	}
+	return ps
+}
+
+type subpath struct {
+	points []layout.Point
+	closed bool
+}
+
+// flatten approximates the subpaths of p with line segments.
+func flatten(p *Path) []subpath {
+	subpaths := []subpath{}
+	cur := subpath{}
+	last := layout.Point{}
+	end := func() {
+		if len(cur.points) > 0 {
+			subpaths = append(subpaths, cur)
+		}
+		cur = subpath{}
+	}
+	for _, i := range p.elements {
+		switch i.op {
+		case moveOp:
+			end()
+			cur.points = []layout.Point{i.points[0]}
+		case lineOp:
+			if len(cur.points) == 0 {
+				cur.points = []layout.Point{last}
+			}
+			cur.points = append(cur.points, i.points[0])
+		case quadOp, cubicOp:
+			if len(cur.points) == 0 {
+				cur.points = []layout.Point{last}
+			}
+			p0 := cur.points[len(cur.points)-1]
+			for j := 1; j <= curveSegments; j++ {
+				t := float64(j) / curveSegments
+				if i.op == quadOp {
+					cur.points = append(cur.points, bezier(t, p0, i.points[0], i.points[1]))
+				} else {
+					cur.points = append(cur.points, bezier(t, p0, i.points[0], i.points[1], i.points[2]))
+				}
+			}
+		case closeOp:
+			if len(cur.points) > 0 {
+				cur.closed = true
+				start := cur.points[0]
+				end()
+				last = start
+				continue
+			}
+		}
+		if len(cur.points) > 0 {
+			last = cur.points[len(cur.points)-1]
+		}
+	}
+	end()
+	return subpaths
+}
+
+// bezier evaluates the bezier curve with the given control points at t, with De Casteljau's algorithm.
+func bezier(t float64, points ...layout.Point) layout.Point {
+	ps := append([]layout.Point(nil), points...)
+	for n := len(ps) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			ps[i] = ps[i].Lerp(ps[i+1], t)
+		}
+	}
+	return ps[0]
+}
+
+// stroke returns polygons that cover the outline of p. Each segment, join and cap is a separate polygon.
+func stroke(p *Path, s StrokeStyle) [][]layout.Point {
+	hw := s.Width / 2
+	if hw <= 0 {
+		return nil
+	}
+	polygons := [][]layout.Point{}
+	for _, sp := range flatten(p) {
+		// Remove zero length segments, which have no direction.
+		pts := []layout.Point{sp.points[0]}
+		for _, i := range sp.points[1:] {
+			if i != pts[len(pts)-1] {
+				pts = append(pts, i)
+			}
+		}
+		if sp.closed && len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+			pts = pts[:len(pts)-1]
+		}
+
+		if len(pts) == 1 {
+			switch s.Cap {
+			case LineCapRound:
+				polygons = append(polygons, circle(pts[0], hw))
+			case LineCapSquare:
+				polygons = append(polygons, []layout.Point{pts[0].Add(layout.Pt(-hw, -hw)), pts[0].Add(layout.Pt(hw, -hw)), pts[0].Add(layout.Pt(hw, hw)), pts[0].Add(layout.Pt(-hw, hw))})
+			}
+			continue
+		}
+
+		if sp.closed {
+			pts = append(pts, pts[0])
+		}
+		normal := func(a, b layout.Point) layout.Point {
+			d := b.Sub(a).Normalize()
+			return layout.Pt(-d.Y*hw, d.X*hw)
+		}
+		for i := 0; i < len(pts)-1; i++ {
+			a, b := pts[i], pts[i+1]
+			n := normal(a, b)
+			polygons = append(polygons, []layout.Point{a.Add(n), b.Add(n), b.Sub(n), a.Sub(n)})
+		}
+
+		// Joins between consecutive segments, including the segments that meet at the start of a closed subpath.
+		join := func(a, v, b layout.Point) {
+			n1, n2 := normal(a, v), normal(v, b)
+			d1, d2 := v.Sub(a), b.Sub(v)
+			cross := d1.X*d2.Y - d1.Y*d2.X
+			if cross == 0 && d1.Dot(d2) > 0 {
+				return
+			}
+			side := -1.0
+			if cross < 0 {
+				side = 1
+			}
+			o1, o2 := v.Add(n1.Mul(side)), v.Add(n2.Mul(side))
+			switch s.Join {
+			case LineJoinRound:
+				polygons = append(polygons, circle(v, hw))
+			case LineJoinMiter:
+				k := n1.Add(n2)
+				if denom := hw*hw + n1.Dot(n2); denom > 0 {
+					m := v.Add(k.Mul(side * hw * hw / denom))
+					if m.Distance(v) <= miterLimit*hw {
+						polygons = append(polygons, []layout.Point{v, o1, m, o2})
+						return
+					}
+				}
+				polygons = append(polygons, []layout.Point{v, o1, o2})
+			default:
+				polygons = append(polygons, []layout.Point{v, o1, o2})
+			}
+		}
+		for i := 1; i < len(pts)-1; i++ {
+			join(pts[i-1], pts[i], pts[i+1])
+		}
+		if sp.closed {
+			join(pts[len(pts)-2], pts[0], pts[1])
+			continue
+		}
+
+		// Caps at the ends of open subpaths.
+		cap := func(v, from layout.Point) {
+			switch s.Cap {
+			case LineCapRound:
+				polygons = append(polygons, circle(v, hw))
+			case LineCapSquare:
+				n := normal(from, v)
+				d := v.Sub(from).Normalize().Mul(hw)
+				polygons = append(polygons, []layout.Point{v.Add(n), v.Add(n).Add(d), v.Sub(n).Add(d), v.Sub(n)})
+			}
+		}
+		cap(pts[0], pts[1])
+		cap(pts[len(pts)-1], pts[len(pts)-2])
+	}
+	return polygons
+}
+
+func circle(c layout.Point, radius float64) []layout.Point {
+	const n = 32
+	ps := make([]layout.Point, n)
+	for i := range ps {
+		a := 2 * math.Pi * float64(i) / n
+		ps[i] = layout.Pt(c.X+radius*math.Cos(a), c.Y+radius*math.Sin(a))
+	}
+	return ps
+}
+
+// area returns the signed area of a polygon, which is positive if its points are clockwise on screen.
+func area(ps []layout.Point) float64 {
+	a := 0.0
+	for i := range ps {
+		p, q := ps[i], ps[(i+1)%len(ps)]
+		a += p.X*q.Y - q.X*p.Y
+	}
+	return a / 2
+}
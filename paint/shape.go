@@ -0,0 +1,373 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/pb"
+	"gomatcha.io/matcha/pb/paint"
+)
+
+// Corner is a bitmask of the corners of a view.
+type Corner int
+
+const (
+	CornerTopLeft Corner = 1 << iota
+	CornerTopRight
+	CornerBottomRight
+	CornerBottomLeft
+	CornerTop    = CornerTopLeft | CornerTopRight
+	CornerBottom = CornerBottomLeft | CornerBottomRight
+	CornerAll    = CornerTop | CornerBottom
+)
+
+// CornerRadii are the radii of each corner of a view. Like CSS, if the radii of adjacent corners are longer than the
+// edge between them, all radii are scaled down until they fit.
+type CornerRadii struct {
+	TopLeft     float64
+	TopRight    float64
+	BottomRight float64
+	BottomLeft  float64
+}
+
+// Radii returns CornerRadii with the given corners set to radius r, and the other corners set to 0.
+//
+//	style := &paint.Style{
+//		CornerRadii: paint.Radii(paint.CornerTop, 12), // A sheet with rounded top corners.
+//	}
+func Radii(corners Corner, r float64) CornerRadii {
+	return CornerRadii{}.With(corners, r)
+}
+
+// With returns c with the given corners set to radius r.
+func (c CornerRadii) With(corners Corner, r float64) CornerRadii {
+	if corners&CornerTopLeft != 0 {
+		c.TopLeft = r
+	}
+	if corners&CornerTopRight != 0 {
+		c.TopRight = r
+	}
+	if corners&CornerBottomRight != 0 {
+		c.BottomRight = r
+	}
+	if corners&CornerBottomLeft != 0 {
+		c.BottomLeft = r
+	}
+	return c
+}
+
+// IsZero returns true if all radii of c are 0.
+func (c CornerRadii) IsZero() bool {
+	return c == CornerRadii{}
+}
+
+// Fit returns c scaled down to fit in a rectangle of the given size.
+func (c CornerRadii) Fit(size layout.Size) CornerRadii {
+	f := 1.0
+	for _, i := range []struct{ length, a, b float64 }{
+		{size.Width, c.TopLeft, c.TopRight},
+		{size.Width, c.BottomLeft, c.BottomRight},
+		{size.Height, c.TopLeft, c.BottomLeft},
+		{size.Height, c.TopRight, c.BottomRight},
+	} {
+		if sum := i.a + i.b; sum > i.length && sum > 0 {
+			f = math.Min(f, math.Max(i.length, 0)/sum)
+		}
+	}
+	if f == 1 {
+		return c
+	}
+	return CornerRadii{c.TopLeft * f, c.TopRight * f, c.BottomRight * f, c.BottomLeft * f}
+}
+
+// MarshalProtobuf serializes c into a protobuf object.
+func (c CornerRadii) MarshalProtobuf() *paint.CornerRadii {
+	if c.IsZero() {
+		return nil
+	}
+	return &paint.CornerRadii{
+		TopLeft:     c.TopLeft,
+		TopRight:    c.TopRight,
+		BottomRight: c.BottomRight,
+		BottomLeft:  c.BottomLeft,
+	}
+}
+
+// UnmarshalProtobuf deserializes c from a protobuf object.
+func (c *CornerRadii) UnmarshalProtobuf(pbc *paint.CornerRadii) {
+	*c = CornerRadii{
+		TopLeft:     pbc.TopLeft,
+		TopRight:    pbc.TopRight,
+		BottomRight: pbc.BottomRight,
+		BottomLeft:  pbc.BottomLeft,
+	}
+}
+
+// Border is a line along one edge of a view.
+type Border struct {
+	Color color.Color
+	Width float64
+	// Dash is a list of alternating lengths of dashes and gaps, in points. If it is empty, the border is solid.
+	Dash []float64
+	// DashPhase is the distance into the dash pattern at which the border starts.
+	DashPhase float64
+}
+
+// MarshalProtobuf serializes b into a protobuf object.
+func (b *Border) MarshalProtobuf() *paint.Border {
+	return &paint.Border{
+		Color:     pb.ColorEncode(b.Color),
+		Width:     b.Width,
+		Dash:      b.Dash,
+		DashPhase: b.DashPhase,
+	}
+}
+
+// UnmarshalProtobuf deserializes b from a protobuf object.
+func (b *Border) UnmarshalProtobuf(pbb *paint.Border) {
+	*b = Border{
+		Color:     pb.ColorDecode(pbb.Color),
+		Width:     pbb.Width,
+		Dash:      pbb.Dash,
+		DashPhase: pbb.DashPhase,
+	}
+}
+
+// Borders are the borders of each edge of a view. Borders are drawn inside the bounds of the view, and follow its
+// corner radii.
+//
+//	style := &paint.Style{
+//		Borders: &paint.Borders{
+//			Bottom: paint.Border{Color: colornames.Gray, Width: 1, Dash: []float64{4, 2}},
+//		},
+//	}
+type Borders struct {
+	Top    Border
+	Left   Border
+	Bottom Border
+	Right  Border
+}
+
+// UniformBorders returns Borders with b on every edge.
+func UniformBorders(b Border) *Borders {
+	return &Borders{Top: b, Left: b, Bottom: b, Right: b}
+}
+
+// Edge returns a pointer to the border of the edge e, which must be a single edge.
+func (b *Borders) Edge(e layout.Edge) *Border {
+	switch e {
+	case layout.EdgeTop:
+		return &b.Top
+	case layout.EdgeLeft:
+		return &b.Left
+	case layout.EdgeBottom:
+		return &b.Bottom
+	case layout.EdgeRight:
+		return &b.Right
+	}
+	return nil
+}
+
+// MarshalProtobuf serializes b into a protobuf object.
+func (b *Borders) MarshalProtobuf() *paint.Borders {
+	if b == nil {
+		return nil
+	}
+	return &paint.Borders{
+		Top:    b.Top.MarshalProtobuf(),
+		Left:   b.Left.MarshalProtobuf(),
+		Bottom: b.Bottom.MarshalProtobuf(),
+		Right:  b.Right.MarshalProtobuf(),
+	}
+}
+
+// UnmarshalProtobuf deserializes b from a protobuf object.
+func (b *Borders) UnmarshalProtobuf(pbb *paint.Borders) {
+	*b = Borders{}
+	for _, i := range []struct {
+		border *Border
+		pb     *paint.Border
+	}{
+		{&b.Top, pbb.Top}, {&b.Left, pbb.Left}, {&b.Bottom, pbb.Bottom}, {&b.Right, pbb.Right},
+	} {
+		if i.pb != nil {
+			i.border.UnmarshalProtobuf(i.pb)
+		}
+	}
+}
+
+// Mask hides parts of a view and its children. If both Image and Path are set, only the area inside of both is
+// visible.
+//
+//	style := &paint.Style{
+//		Mask: &paint.Mask{Image: fadeImage}, // Fades out the bottom of a scroll view.
+//	}
+type Mask struct {
+	// Image is stretched to the bounds of the view, and its alpha channel is the visibility of each point.
+	Image image.Image
+	// Path is a shape in the coordinate space of the view, outside of which the view is hidden.
+	Path *Path
+}
+
+// MarshalProtobuf serializes m into a protobuf object.
+func (m *Mask) MarshalProtobuf() *paint.Mask {
+	if m == nil {
+		return nil
+	}
+	pbm := &paint.Mask{Image: pb.ImageEncode(m.Image)}
+	if m.Path != nil {
+		pbm.Path = m.Path.MarshalProtobuf()
+	}
+	return pbm
+}
+
+// UnmarshalProtobuf deserializes m from a protobuf object.
+func (m *Mask) UnmarshalProtobuf(pbm *paint.Mask) {
+	*m = Mask{}
+	if pbm.Image != nil {
+		m.Image = pb.ImageDecode(pbm.Image)
+	}
+	if pbm.Path != nil {
+		m.Path = &Path{}
+		m.Path.UnmarshalProtobuf(pbm.Path)
+	}
+}
+
+// CornerRadiiNotifier wraps the comm.Notifier interface with an additional Value() method which returns CornerRadii.
+type CornerRadiiNotifier interface {
+	comm.Notifier
+	Value() CornerRadii
+}
+
+// AnimatedCornerRadii is a CornerRadiiNotifier that sets the given Corners of Radii to the value of Radius. If Corners
+// is 0, all corners are set.
+//
+//	value := &animate.Value{}
+//	style := &paint.AnimatedStyle{
+//		CornerRadii: &paint.AnimatedCornerRadii{
+//			Corners: paint.CornerTop,
+//			Radius:  animate.FloatLerp{Start: 0, End: 12}.Notifier(value),
+//		},
+//	}
+type AnimatedCornerRadii struct {
+	Radii   CornerRadii
+	Corners Corner
+	Radius  comm.Float64Notifier
+}
+
+// Value implements the CornerRadiiNotifier interface.
+func (ac *AnimatedCornerRadii) Value() CornerRadii {
+	if ac.Radius == nil {
+		return ac.Radii
+	}
+	corners := ac.Corners
+	if corners == 0 {
+		corners = CornerAll
+	}
+	return ac.Radii.With(corners, ac.Radius.Value())
+}
+
+// Notify implements the CornerRadiiNotifier interface.
+func (ac *AnimatedCornerRadii) Notify(f func()) comm.Id {
+	if ac.Radius == nil {
+		return 0
+	}
+	return ac.Radius.Notify(f)
+}
+
+// Unnotify implements the CornerRadiiNotifier interface.
+func (ac *AnimatedCornerRadii) Unnotify(id comm.Id) {
+	if ac.Radius != nil {
+		ac.Radius.Unnotify(id)
+	}
+}
+
+// BordersNotifier wraps the comm.Notifier interface with an additional Value() method which returns Borders.
+type BordersNotifier interface {
+	comm.Notifier
+	Value() *Borders
+}
+
+// AnimatedBorders is a BordersNotifier that combines animated values with Borders. Color, Width and DashPhase replace
+// the properties of the borders on the given Edges, and are ignored if they are nil. If Edges is 0, all edges are
+// replaced.
+//
+//	value := &animate.Value{}
+//	style := &paint.AnimatedStyle{
+//		Borders: &paint.AnimatedBorders{
+//			Borders:   *paint.UniformBorders(paint.Border{Color: colornames.Black, Width: 1, Dash: []float64{4, 4}}),
+//			DashPhase: animate.FloatLerp{Start: 0, End: 8}.Notifier(value), // Marching ants.
+//		},
+//	}
+type AnimatedBorders struct {
+	Borders   Borders
+	Edges     layout.Edge
+	Color     comm.ColorNotifier
+	Width     comm.Float64Notifier
+	DashPhase comm.Float64Notifier
+
+	maxId          comm.Id
+	groupNotifiers map[comm.Id]notifier
+}
+
+// Value implements the BordersNotifier interface.
+func (ab *AnimatedBorders) Value() *Borders {
+	b := ab.Borders
+	edges := ab.Edges
+	if edges == 0 {
+		edges = layout.EdgeAll
+	}
+	for _, e := range []layout.Edge{layout.EdgeTop, layout.EdgeLeft, layout.EdgeBottom, layout.EdgeRight} {
+		if edges&e == 0 {
+			continue
+		}
+		border := b.Edge(e)
+		if ab.Color != nil {
+			border.Color = ab.Color.Value()
+		}
+		if ab.Width != nil {
+			border.Width = ab.Width.Value()
+		}
+		if ab.DashPhase != nil {
+			border.DashPhase = ab.DashPhase.Value()
+		}
+	}
+	return &b
+}
+
+// Notify implements the BordersNotifier interface.
+func (ab *AnimatedBorders) Notify(f func()) comm.Id {
+	n := &comm.Relay{}
+
+	if ab.Color != nil {
+		n.Subscribe(ab.Color)
+	}
+	if ab.Width != nil {
+		n.Subscribe(ab.Width)
+	}
+	if ab.DashPhase != nil {
+		n.Subscribe(ab.DashPhase)
+	}
+
+	ab.maxId += 1
+	if ab.groupNotifiers == nil {
+		ab.groupNotifiers = map[comm.Id]notifier{}
+	}
+	ab.groupNotifiers[ab.maxId] = notifier{
+		notifier: n,
+		id:       n.Notify(f),
+	}
+	return ab.maxId
+}
+
+// Unnotify implements the BordersNotifier interface.
+func (ab *AnimatedBorders) Unnotify(id comm.Id) {
+	n, ok := ab.groupNotifiers[id]
+	if ok {
+		n.notifier.Unnotify(n.id)
+		delete(ab.groupNotifiers, id)
+	}
+}
@@ -0,0 +1,109 @@
+package paint
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"gomatcha.io/matcha/layout"
+)
+
+func TestCornerRadii(t *testing.T) {
+	c := Radii(CornerTop, 10).With(CornerBottomLeft, 5)
+	if c != (CornerRadii{TopLeft: 10, TopRight: 10, BottomLeft: 5}) {
+		t.Errorf("Incorrect radii: %v", c)
+	}
+	// The top edge is too short for both radii, so all radii are halved.
+	if f := c.Fit(layout.Size{Width: 10, Height: 100}); f != (CornerRadii{TopLeft: 5, TopRight: 5, BottomLeft: 2.5}) {
+		t.Errorf("Incorrect fit: %v", f)
+	}
+	if f := c.Fit(layout.Size{Width: 100, Height: 100}); f != c {
+		t.Errorf("Incorrect fit: %v", f)
+	}
+
+	s := &Style{CornerRadius: 3}
+	if r := s.Radii(); r != Radii(CornerAll, 3) {
+		t.Errorf("Incorrect style radii: %v", r)
+	}
+	s.CornerRadii = c
+	if r := s.Radii(); r != c {
+		t.Errorf("Incorrect style radii: %v", r)
+	}
+}
+
+func TestRoundedRectCorners(t *testing.T) {
+	p := &Path{}
+	p.AddRoundedRectCorners(layout.Rt(0, 0, 20, 20), Radii(CornerTopLeft, 10))
+	c := NewCanvas(layout.Pt(20, 20))
+	c.Fill(p, red)
+	img := c.Drawing().Rasterize(20, 20)
+
+	if c := img.RGBAAt(0, 0); c != (color.RGBA{}) {
+		t.Errorf("Incorrect rounded corner: %v", c)
+	}
+	for _, i := range []image.Point{{19, 0}, {0, 19}, {19, 19}, {5, 5}} {
+		if c := img.RGBAAt(i.X, i.Y); c != red {
+			t.Errorf("Pixel %v = %v, expected %v", i, c, red)
+		}
+	}
+}
+
+func TestShapeProtobuf(t *testing.T) {
+	c := CornerRadii{1, 2, 3, 4}
+	c2 := CornerRadii{}
+	c2.UnmarshalProtobuf(c.MarshalProtobuf())
+	if c != c2 {
+		t.Errorf("Incorrect corner radii: %v", c2)
+	}
+	if (CornerRadii{}).MarshalProtobuf() != nil {
+		t.Error("Expected nil")
+	}
+
+	b := &Borders{
+		Top:   Border{Color: color.RGBA64{1, 2, 3, 4}, Width: 2, Dash: []float64{4, 2}, DashPhase: 1},
+		Right: Border{Width: 1},
+	}
+	b2 := &Borders{}
+	b2.UnmarshalProtobuf(b.MarshalProtobuf())
+	if !reflect.DeepEqual(b, b2) {
+		t.Errorf("Incorrect borders: %+v", b2)
+	}
+
+	p := &Path{}
+	p.AddEllipse(layout.Rt(0, 0, 10, 10))
+	m := &Mask{Image: image.NewRGBA(image.Rect(0, 0, 2, 2)), Path: p}
+	m2 := &Mask{}
+	m2.UnmarshalProtobuf(m.MarshalProtobuf())
+	if !reflect.DeepEqual(m.Image, m2.Image) || !proto.Equal(m.Path.MarshalProtobuf(), m2.Path.MarshalProtobuf()) {
+		t.Errorf("Incorrect mask: %+v", m2)
+	}
+}
+
+func TestAnimatedBorders(t *testing.T) {
+	col := &colorNotifier{color: blue}
+	ab := &AnimatedBorders{
+		Borders: *UniformBorders(Border{Color: red, Width: 1}),
+		Edges:   layout.EdgeBottom,
+		Color:   col,
+	}
+	as := &AnimatedStyle{Borders: ab}
+
+	count := 0
+	id := as.Notify(func() { count += 1 })
+	col.Signal()
+	if count != 1 {
+		t.Errorf("Expected notification")
+	}
+	as.Unnotify(id)
+
+	s := as.PaintStyle()
+	b := s.EdgeBorders()
+	if b.Bottom.Color != blue || b.Top.Color != red || b.Bottom.Width != 1 {
+		t.Errorf("Incorrect borders: %+v", b)
+	}
+	if s := (&Style{BorderColor: red, BorderWidth: 2}).EdgeBorders(); !reflect.DeepEqual(s, *UniformBorders(Border{Color: red, Width: 2})) {
+		t.Errorf("Incorrect uniform borders: %+v", s)
+	}
+}
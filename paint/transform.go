@@ -0,0 +1,77 @@
+package paint
+
+import (
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+)
+
+// AnimatedTransform is a layout.TransformNotifier that combines animated values. Transform is applied first,
+// followed by Scale, Rotation and Translation.
+//
+//	rotation := &animate.Value{}
+//	style := &paint.AnimatedStyle{
+//		Transform: &paint.AnimatedTransform{Rotation: rotation},
+//	}
+//	rotation.Run(&animate.Basic{End: math.Pi, Dur: time.Second})
+type AnimatedTransform struct {
+	Transform layout.Transform
+	// Scale scales the view uniformly in x and y.
+	Scale comm.Float64Notifier
+	// Rotation rotates the view clockwise around the z axis, in radians.
+	Rotation    comm.Float64Notifier
+	Translation layout.PointNotifier
+
+	maxId          comm.Id
+	groupNotifiers map[comm.Id]notifier
+}
+
+// Value implements the layout.TransformNotifier interface.
+func (at *AnimatedTransform) Value() layout.Transform {
+	t := at.Transform
+	if at.Scale != nil {
+		s := at.Scale.Value()
+		t = t.Scale(s, s, 1)
+	}
+	if at.Rotation != nil {
+		t = t.Rotate(at.Rotation.Value(), 0, 0, 1)
+	}
+	if at.Translation != nil {
+		p := at.Translation.Value()
+		t = t.Translate(p.X, p.Y, 0)
+	}
+	return t
+}
+
+// Notify implements the layout.TransformNotifier interface.
+func (at *AnimatedTransform) Notify(f func()) comm.Id {
+	n := &comm.Relay{}
+
+	if at.Scale != nil {
+		n.Subscribe(at.Scale)
+	}
+	if at.Rotation != nil {
+		n.Subscribe(at.Rotation)
+	}
+	if at.Translation != nil {
+		n.Subscribe(at.Translation)
+	}
+
+	at.maxId += 1
+	if at.groupNotifiers == nil {
+		at.groupNotifiers = map[comm.Id]notifier{}
+	}
+	at.groupNotifiers[at.maxId] = notifier{
+		notifier: n,
+		id:       n.Notify(f),
+	}
+	return at.maxId
+}
+
+// Unnotify implements the layout.TransformNotifier interface.
+func (at *AnimatedTransform) Unnotify(id comm.Id) {
+	n, ok := at.groupNotifiers[id]
+	if ok {
+		n.notifier.Unnotify(n.id)
+		delete(at.groupNotifiers, id)
+	}
+}
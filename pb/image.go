@@ -18,6 +18,18 @@ func ColorEncode(c color.Color) *Color {
 	}
 }
 
+func ColorDecode(c *Color) color.Color {
+	if c == nil {
+		return nil
+	}
+	return color.RGBA64{
+		R: uint16(c.Red),
+		G: uint16(c.Green),
+		B: uint16(c.Blue),
+		A: uint16(c.Alpha),
+	}
+}
+
 func ImageEncode(img image.Image) *Image {
 	if img == nil {
 		return nil
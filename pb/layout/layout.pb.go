@@ -11,6 +11,7 @@ It has these top-level messages:
 	Point
 	Rect
 	Insets
+	Transform
 	Guide
 */
 package layout
@@ -118,15 +119,162 @@ func (m *Insets) GetRight() float64 {
 	return 0
 }
 
+// Transform is a 4x4 matrix applied to row vectors, around the anchor point.
+// The anchor point is in unit coordinates of the view's frame.
+type Transform struct {
+	M11    float64 `protobuf:"fixed64,1,opt,name=m11" json:"m11,omitempty"`
+	M12    float64 `protobuf:"fixed64,2,opt,name=m12" json:"m12,omitempty"`
+	M13    float64 `protobuf:"fixed64,3,opt,name=m13" json:"m13,omitempty"`
+	M14    float64 `protobuf:"fixed64,4,opt,name=m14" json:"m14,omitempty"`
+	M21    float64 `protobuf:"fixed64,5,opt,name=m21" json:"m21,omitempty"`
+	M22    float64 `protobuf:"fixed64,6,opt,name=m22" json:"m22,omitempty"`
+	M23    float64 `protobuf:"fixed64,7,opt,name=m23" json:"m23,omitempty"`
+	M24    float64 `protobuf:"fixed64,8,opt,name=m24" json:"m24,omitempty"`
+	M31    float64 `protobuf:"fixed64,9,opt,name=m31" json:"m31,omitempty"`
+	M32    float64 `protobuf:"fixed64,10,opt,name=m32" json:"m32,omitempty"`
+	M33    float64 `protobuf:"fixed64,11,opt,name=m33" json:"m33,omitempty"`
+	M34    float64 `protobuf:"fixed64,12,opt,name=m34" json:"m34,omitempty"`
+	M41    float64 `protobuf:"fixed64,13,opt,name=m41" json:"m41,omitempty"`
+	M42    float64 `protobuf:"fixed64,14,opt,name=m42" json:"m42,omitempty"`
+	M43    float64 `protobuf:"fixed64,15,opt,name=m43" json:"m43,omitempty"`
+	M44    float64 `protobuf:"fixed64,16,opt,name=m44" json:"m44,omitempty"`
+	Anchor *Point  `protobuf:"bytes,17,opt,name=anchor" json:"anchor,omitempty"`
+}
+
+func (m *Transform) Reset()                    { *m = Transform{} }
+func (m *Transform) String() string            { return proto.CompactTextString(m) }
+func (*Transform) ProtoMessage()               {}
+func (*Transform) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Transform) GetM11() float64 {
+	if m != nil {
+		return m.M11
+	}
+	return 0
+}
+
+func (m *Transform) GetM12() float64 {
+	if m != nil {
+		return m.M12
+	}
+	return 0
+}
+
+func (m *Transform) GetM13() float64 {
+	if m != nil {
+		return m.M13
+	}
+	return 0
+}
+
+func (m *Transform) GetM14() float64 {
+	if m != nil {
+		return m.M14
+	}
+	return 0
+}
+
+func (m *Transform) GetM21() float64 {
+	if m != nil {
+		return m.M21
+	}
+	return 0
+}
+
+func (m *Transform) GetM22() float64 {
+	if m != nil {
+		return m.M22
+	}
+	return 0
+}
+
+func (m *Transform) GetM23() float64 {
+	if m != nil {
+		return m.M23
+	}
+	return 0
+}
+
+func (m *Transform) GetM24() float64 {
+	if m != nil {
+		return m.M24
+	}
+	return 0
+}
+
+func (m *Transform) GetM31() float64 {
+	if m != nil {
+		return m.M31
+	}
+	return 0
+}
+
+func (m *Transform) GetM32() float64 {
+	if m != nil {
+		return m.M32
+	}
+	return 0
+}
+
+func (m *Transform) GetM33() float64 {
+	if m != nil {
+		return m.M33
+	}
+	return 0
+}
+
+func (m *Transform) GetM34() float64 {
+	if m != nil {
+		return m.M34
+	}
+	return 0
+}
+
+func (m *Transform) GetM41() float64 {
+	if m != nil {
+		return m.M41
+	}
+	return 0
+}
+
+func (m *Transform) GetM42() float64 {
+	if m != nil {
+		return m.M42
+	}
+	return 0
+}
+
+func (m *Transform) GetM43() float64 {
+	if m != nil {
+		return m.M43
+	}
+	return 0
+}
+
+func (m *Transform) GetM44() float64 {
+	if m != nil {
+		return m.M44
+	}
+	return 0
+}
+
+func (m *Transform) GetAnchor() *Point {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
 type Guide struct {
-	Frame  *Rect `protobuf:"bytes,1,opt,name=frame" json:"frame,omitempty"`
-	ZIndex int64 `protobuf:"varint,3,opt,name=zIndex" json:"zIndex,omitempty"`
+	Frame     *Rect      `protobuf:"bytes,1,opt,name=frame" json:"frame,omitempty"`
+	ZIndex    int64      `protobuf:"varint,3,opt,name=zIndex" json:"zIndex,omitempty"`
+	Transform *Transform `protobuf:"bytes,4,opt,name=transform" json:"transform,omitempty"`
 }
 
 func (m *Guide) Reset()                    { *m = Guide{} }
 func (m *Guide) String() string            { return proto.CompactTextString(m) }
 func (*Guide) ProtoMessage()               {}
-func (*Guide) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Guide) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Guide) GetFrame() *Rect {
 	if m != nil {
@@ -142,31 +290,49 @@ func (m *Guide) GetZIndex() int64 {
 	return 0
 }
 
+func (m *Guide) GetTransform() *Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Point)(nil), "matcha.layout.Point")
 	proto.RegisterType((*Rect)(nil), "matcha.layout.Rect")
 	proto.RegisterType((*Insets)(nil), "matcha.layout.Insets")
+	proto.RegisterType((*Transform)(nil), "matcha.layout.Transform")
 	proto.RegisterType((*Guide)(nil), "matcha.layout.Guide")
 }
 
 func init() { proto.RegisterFile("gomatcha.io/matcha/pb/layout/layout.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
-	0x10, 0xc7, 0xd9, 0xe6, 0xe3, 0x30, 0x7e, 0x20, 0x6b, 0x91, 0x78, 0x93, 0x08, 0x62, 0x2f, 0x09,
-	0xe8, 0x1b, 0xf4, 0x22, 0x15, 0x85, 0xb2, 0x07, 0x0f, 0xe2, 0x65, 0xd3, 0x6e, 0xdb, 0x85, 0x26,
-	0x13, 0xd2, 0x29, 0x6c, 0x7c, 0x1c, 0x9f, 0x54, 0x76, 0x76, 0x2f, 0x0a, 0x3d, 0x65, 0x7e, 0xc3,
-	0x6f, 0x66, 0xfe, 0x59, 0x98, 0x6d, 0xb1, 0xd5, 0xb4, 0xda, 0xe9, 0xca, 0x62, 0x1d, 0xaa, 0xba,
-	0x6f, 0xea, 0xbd, 0x1e, 0xf1, 0x48, 0xf1, 0x53, 0xf5, 0x03, 0x12, 0xca, 0x8b, 0x28, 0x86, 0x66,
-	0x79, 0x0f, 0xd9, 0x12, 0x6d, 0x47, 0xf2, 0x1c, 0x84, 0x2b, 0xc4, 0x9d, 0x78, 0x14, 0x4a, 0x38,
-	0x4f, 0x63, 0x31, 0x09, 0x34, 0x96, 0x1f, 0x90, 0x2a, 0xb3, 0x22, 0xf9, 0x00, 0x49, 0x6b, 0x3b,
-	0xb6, 0xce, 0x9e, 0xa6, 0xd5, 0x9f, 0x4d, 0x15, 0xaf, 0x51, 0x5e, 0x60, 0x4f, 0x3b, 0x9e, 0x3f,
-	0xed, 0x69, 0x57, 0x7e, 0x41, 0xbe, 0xe8, 0x0e, 0x86, 0x0e, 0xf2, 0x0a, 0x12, 0xc2, 0x3e, 0xde,
-	0xf7, 0xa5, 0x94, 0x90, 0xee, 0xcd, 0x86, 0x62, 0x08, 0xae, 0xe5, 0x0d, 0xe4, 0x0d, 0x12, 0x61,
-	0x5b, 0x24, 0xdc, 0x8d, 0x24, 0xa7, 0x90, 0x0d, 0x76, 0xbb, 0xa3, 0x22, 0xe5, 0x76, 0x80, 0xf2,
-	0x15, 0xb2, 0x97, 0xa3, 0x5d, 0x1b, 0x39, 0x83, 0x6c, 0x33, 0xe8, 0xd6, 0xc4, 0xe0, 0xd7, 0xff,
-	0x02, 0xf9, 0x5f, 0x53, 0xc1, 0xf0, 0x17, 0xbe, 0x17, 0xdd, 0xda, 0x38, 0xbe, 0x90, 0xa8, 0x48,
-	0xf3, 0xdb, 0xcf, 0x3c, 0xd8, 0x3f, 0x93, 0xcb, 0x77, 0x9e, 0x7e, 0x63, 0x5c, 0xce, 0x9b, 0x9c,
-	0xdf, 0xf5, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x76, 0x28, 0xcb, 0x84, 0x01, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0xab, 0xd3, 0x40,
+	0x10, 0xc7, 0x49, 0xd2, 0x44, 0x3b, 0x7d, 0xef, 0xf9, 0x5c, 0x1f, 0x32, 0xde, 0x24, 0x82, 0xf8,
+	0x40, 0x52, 0xb2, 0xbb, 0xf8, 0x01, 0x7a, 0x91, 0x82, 0x42, 0x09, 0xe2, 0x41, 0xbc, 0x6c, 0xdb,
+	0xb4, 0x0d, 0x34, 0xd9, 0x92, 0x6e, 0x21, 0xf5, 0xe8, 0x47, 0xf1, 0x5b, 0xf9, 0x6d, 0x64, 0x77,
+	0x76, 0x15, 0x7b, 0xe8, 0x29, 0x33, 0xbf, 0xfc, 0xf3, 0x9b, 0xb0, 0xb3, 0xf0, 0xb8, 0xd5, 0xad,
+	0x32, 0xab, 0x9d, 0x2a, 0x1a, 0x3d, 0xa5, 0x6a, 0x7a, 0x58, 0x4e, 0xf7, 0xea, 0xac, 0x4f, 0xc6,
+	0x3f, 0x8a, 0x43, 0xaf, 0x8d, 0x66, 0xb7, 0x3e, 0x48, 0x30, 0x7f, 0x03, 0xe9, 0x42, 0x37, 0x9d,
+	0x61, 0x37, 0x10, 0x0d, 0x18, 0xbd, 0x8e, 0xde, 0x45, 0x55, 0x34, 0xd8, 0xee, 0x8c, 0x31, 0x75,
+	0xe7, 0xfc, 0x2b, 0x8c, 0xaa, 0x7a, 0x65, 0xd8, 0x5b, 0x48, 0xda, 0xa6, 0x73, 0xa9, 0x09, 0x7f,
+	0x28, 0xfe, 0x33, 0x15, 0x4e, 0x53, 0xd9, 0x80, 0xcb, 0xa9, 0x01, 0xe3, 0xab, 0x39, 0x35, 0xe4,
+	0xdf, 0x21, 0x9b, 0x77, 0xc7, 0xda, 0x1c, 0xd9, 0x3d, 0x24, 0x46, 0x1f, 0xfc, 0x7c, 0x5b, 0x32,
+	0x06, 0xa3, 0x7d, 0xbd, 0x31, 0xfe, 0x27, 0x5c, 0xcd, 0x5e, 0x42, 0xb6, 0xd4, 0xc6, 0xe8, 0x16,
+	0x13, 0x47, 0x7d, 0xc7, 0x1e, 0x20, 0xed, 0x9b, 0xed, 0xce, 0xe0, 0xc8, 0x61, 0x6a, 0xf2, 0xdf,
+	0x31, 0x8c, 0xbf, 0xf4, 0xaa, 0x3b, 0x6e, 0x74, 0xdf, 0xda, 0x09, 0x6d, 0x59, 0x86, 0x09, 0x6d,
+	0x59, 0x12, 0xe1, 0x7e, 0x80, 0x2d, 0x89, 0x08, 0x2f, 0xb7, 0x25, 0x11, 0xe9, 0xbd, 0xb6, 0x74,
+	0x84, 0x97, 0x98, 0x7a, 0xc2, 0xc9, 0xc3, 0x39, 0x66, 0x81, 0x90, 0x87, 0x0b, 0x7c, 0x12, 0x08,
+	0x79, 0xb8, 0xc4, 0xa7, 0x81, 0x90, 0x47, 0x94, 0x38, 0xf6, 0x44, 0x90, 0x47, 0x70, 0x84, 0x40,
+	0xc8, 0x23, 0x04, 0x4e, 0x02, 0x21, 0x8f, 0x90, 0x78, 0x13, 0x08, 0x79, 0x64, 0x89, 0xb7, 0x9e,
+	0x48, 0xf2, 0x48, 0x8e, 0x77, 0x81, 0x90, 0x47, 0x0a, 0x7c, 0x16, 0x08, 0x79, 0xa4, 0xc4, 0xfb,
+	0x40, 0x24, 0x7b, 0x0f, 0x99, 0xea, 0x56, 0x3b, 0xdd, 0xe3, 0xf3, 0x2b, 0x6b, 0xf3, 0x99, 0xfc,
+	0x67, 0x04, 0xe9, 0xc7, 0x53, 0xb3, 0xae, 0xd9, 0x23, 0xa4, 0x9b, 0x5e, 0xb5, 0xb5, 0xbf, 0x15,
+	0x2f, 0x2e, 0x3e, 0xb3, 0xf7, 0xa6, 0xa2, 0x84, 0x5d, 0xdf, 0x8f, 0x79, 0xb7, 0xae, 0x07, 0x77,
+	0xc2, 0x49, 0xe5, 0x3b, 0xf6, 0x01, 0xc6, 0x26, 0xec, 0xc9, 0x1d, 0xf5, 0x84, 0xe3, 0x85, 0xe6,
+	0xef, 0x1e, 0xab, 0x7f, 0xd1, 0xd9, 0xab, 0x6f, 0x19, 0xbd, 0xfe, 0x15, 0xdf, 0x7d, 0x76, 0xf1,
+	0x4f, 0xae, 0x5d, 0xcc, 0x96, 0x99, 0xbb, 0xec, 0xe2, 0xcf, 0x00, 0x7c, 0xfd, 0xd0, 0x22, 0x19,
+	0x03, 0x00, 0x00,
 }
@@ -9,6 +9,16 @@ It is generated from these files:
 
 It has these top-level messages:
 	Style
+	CornerRadii
+	Border
+	Borders
+	Mask
+	GradientStop
+	Gradient
+	PathElement
+	Path
+	DrawCommand
+	Drawing
 */
 package paint
 
@@ -16,7 +26,9 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import matcha "gomatcha.io/matcha/pb"
+import matcha1 "gomatcha.io/matcha/pb"
 import matcha_layout "gomatcha.io/matcha/pb/layout"
+import matcha_text "gomatcha.io/matcha/pb/text"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -29,6 +41,144 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type GradientKind int32
+
+const (
+	GradientKind_GRADIENT_KIND_LINEAR GradientKind = 0
+	GradientKind_GRADIENT_KIND_RADIAL GradientKind = 1
+)
+
+var GradientKind_name = map[int32]string{
+	0: "GRADIENT_KIND_LINEAR",
+	1: "GRADIENT_KIND_RADIAL",
+}
+var GradientKind_value = map[string]int32{
+	"GRADIENT_KIND_LINEAR": 0,
+	"GRADIENT_KIND_RADIAL": 1,
+}
+
+func (x GradientKind) String() string {
+	return proto.EnumName(GradientKind_name, int32(x))
+}
+func (GradientKind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type PathOp int32
+
+const (
+	PathOp_PATH_OP_MOVE  PathOp = 0
+	PathOp_PATH_OP_LINE  PathOp = 1
+	PathOp_PATH_OP_QUAD  PathOp = 2
+	PathOp_PATH_OP_CUBIC PathOp = 3
+	PathOp_PATH_OP_CLOSE PathOp = 4
+)
+
+var PathOp_name = map[int32]string{
+	0: "PATH_OP_MOVE",
+	1: "PATH_OP_LINE",
+	2: "PATH_OP_QUAD",
+	3: "PATH_OP_CUBIC",
+	4: "PATH_OP_CLOSE",
+}
+var PathOp_value = map[string]int32{
+	"PATH_OP_MOVE":  0,
+	"PATH_OP_LINE":  1,
+	"PATH_OP_QUAD":  2,
+	"PATH_OP_CUBIC": 3,
+	"PATH_OP_CLOSE": 4,
+}
+
+func (x PathOp) String() string {
+	return proto.EnumName(PathOp_name, int32(x))
+}
+func (PathOp) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type DrawOp int32
+
+const (
+	DrawOp_DRAW_OP_SAVE    DrawOp = 0
+	DrawOp_DRAW_OP_RESTORE DrawOp = 1
+	DrawOp_DRAW_OP_CONCAT  DrawOp = 2
+	DrawOp_DRAW_OP_CLIP    DrawOp = 3
+	DrawOp_DRAW_OP_FILL    DrawOp = 4
+	DrawOp_DRAW_OP_STROKE  DrawOp = 5
+	DrawOp_DRAW_OP_TEXT    DrawOp = 6
+	DrawOp_DRAW_OP_IMAGE   DrawOp = 7
+)
+
+var DrawOp_name = map[int32]string{
+	0: "DRAW_OP_SAVE",
+	1: "DRAW_OP_RESTORE",
+	2: "DRAW_OP_CONCAT",
+	3: "DRAW_OP_CLIP",
+	4: "DRAW_OP_FILL",
+	5: "DRAW_OP_STROKE",
+	6: "DRAW_OP_TEXT",
+	7: "DRAW_OP_IMAGE",
+}
+var DrawOp_value = map[string]int32{
+	"DRAW_OP_SAVE":    0,
+	"DRAW_OP_RESTORE": 1,
+	"DRAW_OP_CONCAT":  2,
+	"DRAW_OP_CLIP":    3,
+	"DRAW_OP_FILL":    4,
+	"DRAW_OP_STROKE":  5,
+	"DRAW_OP_TEXT":    6,
+	"DRAW_OP_IMAGE":   7,
+}
+
+func (x DrawOp) String() string {
+	return proto.EnumName(DrawOp_name, int32(x))
+}
+func (DrawOp) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type LineCap int32
+
+const (
+	LineCap_LINE_CAP_BUTT   LineCap = 0
+	LineCap_LINE_CAP_ROUND  LineCap = 1
+	LineCap_LINE_CAP_SQUARE LineCap = 2
+)
+
+var LineCap_name = map[int32]string{
+	0: "LINE_CAP_BUTT",
+	1: "LINE_CAP_ROUND",
+	2: "LINE_CAP_SQUARE",
+}
+var LineCap_value = map[string]int32{
+	"LINE_CAP_BUTT":   0,
+	"LINE_CAP_ROUND":  1,
+	"LINE_CAP_SQUARE": 2,
+}
+
+func (x LineCap) String() string {
+	return proto.EnumName(LineCap_name, int32(x))
+}
+func (LineCap) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type LineJoin int32
+
+const (
+	LineJoin_LINE_JOIN_MITER LineJoin = 0
+	LineJoin_LINE_JOIN_ROUND LineJoin = 1
+	LineJoin_LINE_JOIN_BEVEL LineJoin = 2
+)
+
+var LineJoin_name = map[int32]string{
+	0: "LINE_JOIN_MITER",
+	1: "LINE_JOIN_ROUND",
+	2: "LINE_JOIN_BEVEL",
+}
+var LineJoin_value = map[string]int32{
+	"LINE_JOIN_MITER": 0,
+	"LINE_JOIN_ROUND": 1,
+	"LINE_JOIN_BEVEL": 2,
+}
+
+func (x LineJoin) String() string {
+	return proto.EnumName(LineJoin_name, int32(x))
+}
+func (LineJoin) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
 type Style struct {
 	Transparency    float64              `protobuf:"fixed64,1,opt,name=transparency" json:"transparency,omitempty"`
 	BackgroundColor *matcha.Color        `protobuf:"bytes,2,opt,name=backgroundColor" json:"backgroundColor,omitempty"`
@@ -38,6 +188,20 @@ type Style struct {
 	ShadowRadius    float64              `protobuf:"fixed64,7,opt,name=shadowRadius" json:"shadowRadius,omitempty"`
 	ShadowOffset    *matcha_layout.Point `protobuf:"bytes,8,opt,name=shadowOffset" json:"shadowOffset,omitempty"`
 	ShadowColor     *matcha.Color        `protobuf:"bytes,9,opt,name=shadowColor" json:"shadowColor,omitempty"`
+	// Not applied by the iOS client yet.
+	Transform *matcha_layout.Transform `protobuf:"bytes,10,opt,name=transform" json:"transform,omitempty"`
+	// Not applied by the iOS client yet.
+	BackgroundGradient *Gradient `protobuf:"bytes,11,opt,name=backgroundGradient" json:"backgroundGradient,omitempty"`
+	// Not applied by the iOS client yet.
+	Drawing *Drawing `protobuf:"bytes,12,opt,name=drawing" json:"drawing,omitempty"`
+	// Not applied by the iOS client yet.
+	CornerRadii *CornerRadii `protobuf:"bytes,13,opt,name=cornerRadii" json:"cornerRadii,omitempty"`
+	// Not applied by the iOS client yet.
+	ClipsChildren bool `protobuf:"varint,14,opt,name=clipsChildren" json:"clipsChildren,omitempty"`
+	// Not applied by the iOS client yet.
+	Borders *Borders `protobuf:"bytes,15,opt,name=borders" json:"borders,omitempty"`
+	// Not applied by the iOS client yet.
+	Mask *Mask `protobuf:"bytes,16,opt,name=mask" json:"mask,omitempty"`
 }
 
 func (m *Style) Reset()                    { *m = Style{} }
@@ -101,30 +265,527 @@ func (m *Style) GetShadowColor() *matcha.Color {
 	return nil
 }
 
+func (m *Style) GetTransform() *matcha_layout.Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *Style) GetBackgroundGradient() *Gradient {
+	if m != nil {
+		return m.BackgroundGradient
+	}
+	return nil
+}
+
+func (m *Style) GetDrawing() *Drawing {
+	if m != nil {
+		return m.Drawing
+	}
+	return nil
+}
+
+func (m *Style) GetCornerRadii() *CornerRadii {
+	if m != nil {
+		return m.CornerRadii
+	}
+	return nil
+}
+
+func (m *Style) GetClipsChildren() bool {
+	if m != nil {
+		return m.ClipsChildren
+	}
+	return false
+}
+
+func (m *Style) GetBorders() *Borders {
+	if m != nil {
+		return m.Borders
+	}
+	return nil
+}
+
+func (m *Style) GetMask() *Mask {
+	if m != nil {
+		return m.Mask
+	}
+	return nil
+}
+
+type CornerRadii struct {
+	TopLeft     float64 `protobuf:"fixed64,1,opt,name=topLeft" json:"topLeft,omitempty"`
+	TopRight    float64 `protobuf:"fixed64,2,opt,name=topRight" json:"topRight,omitempty"`
+	BottomRight float64 `protobuf:"fixed64,3,opt,name=bottomRight" json:"bottomRight,omitempty"`
+	BottomLeft  float64 `protobuf:"fixed64,4,opt,name=bottomLeft" json:"bottomLeft,omitempty"`
+}
+
+func (m *CornerRadii) Reset()                    { *m = CornerRadii{} }
+func (m *CornerRadii) String() string            { return proto.CompactTextString(m) }
+func (*CornerRadii) ProtoMessage()               {}
+func (*CornerRadii) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *CornerRadii) GetTopLeft() float64 {
+	if m != nil {
+		return m.TopLeft
+	}
+	return 0
+}
+
+func (m *CornerRadii) GetTopRight() float64 {
+	if m != nil {
+		return m.TopRight
+	}
+	return 0
+}
+
+func (m *CornerRadii) GetBottomRight() float64 {
+	if m != nil {
+		return m.BottomRight
+	}
+	return 0
+}
+
+func (m *CornerRadii) GetBottomLeft() float64 {
+	if m != nil {
+		return m.BottomLeft
+	}
+	return 0
+}
+
+type Border struct {
+	Color     *matcha.Color `protobuf:"bytes,1,opt,name=color" json:"color,omitempty"`
+	Width     float64       `protobuf:"fixed64,2,opt,name=width" json:"width,omitempty"`
+	Dash      []float64     `protobuf:"fixed64,3,rep,packed,name=dash" json:"dash,omitempty"`
+	DashPhase float64       `protobuf:"fixed64,4,opt,name=dashPhase" json:"dashPhase,omitempty"`
+}
+
+func (m *Border) Reset()                    { *m = Border{} }
+func (m *Border) String() string            { return proto.CompactTextString(m) }
+func (*Border) ProtoMessage()               {}
+func (*Border) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Border) GetColor() *matcha.Color {
+	if m != nil {
+		return m.Color
+	}
+	return nil
+}
+
+func (m *Border) GetWidth() float64 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *Border) GetDash() []float64 {
+	if m != nil {
+		return m.Dash
+	}
+	return nil
+}
+
+func (m *Border) GetDashPhase() float64 {
+	if m != nil {
+		return m.DashPhase
+	}
+	return 0
+}
+
+type Borders struct {
+	Top    *Border `protobuf:"bytes,1,opt,name=top" json:"top,omitempty"`
+	Left   *Border `protobuf:"bytes,2,opt,name=left" json:"left,omitempty"`
+	Bottom *Border `protobuf:"bytes,3,opt,name=bottom" json:"bottom,omitempty"`
+	Right  *Border `protobuf:"bytes,4,opt,name=right" json:"right,omitempty"`
+}
+
+func (m *Borders) Reset()                    { *m = Borders{} }
+func (m *Borders) String() string            { return proto.CompactTextString(m) }
+func (*Borders) ProtoMessage()               {}
+func (*Borders) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Borders) GetTop() *Border {
+	if m != nil {
+		return m.Top
+	}
+	return nil
+}
+
+func (m *Borders) GetLeft() *Border {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *Borders) GetBottom() *Border {
+	if m != nil {
+		return m.Bottom
+	}
+	return nil
+}
+
+func (m *Borders) GetRight() *Border {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+type Mask struct {
+	Image *matcha1.Image `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	Path  *Path          `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *Mask) Reset()                    { *m = Mask{} }
+func (m *Mask) String() string            { return proto.CompactTextString(m) }
+func (*Mask) ProtoMessage()               {}
+func (*Mask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Mask) GetImage() *matcha1.Image {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *Mask) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type GradientStop struct {
+	Location float64       `protobuf:"fixed64,1,opt,name=location" json:"location,omitempty"`
+	Color    *matcha.Color `protobuf:"bytes,2,opt,name=color" json:"color,omitempty"`
+}
+
+func (m *GradientStop) Reset()                    { *m = GradientStop{} }
+func (m *GradientStop) String() string            { return proto.CompactTextString(m) }
+func (*GradientStop) ProtoMessage()               {}
+func (*GradientStop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *GradientStop) GetLocation() float64 {
+	if m != nil {
+		return m.Location
+	}
+	return 0
+}
+
+func (m *GradientStop) GetColor() *matcha.Color {
+	if m != nil {
+		return m.Color
+	}
+	return nil
+}
+
+type Gradient struct {
+	Kind   GradientKind         `protobuf:"varint,1,opt,name=kind,enum=matcha.paint.GradientKind" json:"kind,omitempty"`
+	Stops  []*GradientStop      `protobuf:"bytes,2,rep,name=stops" json:"stops,omitempty"`
+	Angle  float64              `protobuf:"fixed64,3,opt,name=angle" json:"angle,omitempty"`
+	Center *matcha_layout.Point `protobuf:"bytes,4,opt,name=center" json:"center,omitempty"`
+	Radius float64              `protobuf:"fixed64,5,opt,name=radius" json:"radius,omitempty"`
+}
+
+func (m *Gradient) Reset()                    { *m = Gradient{} }
+func (m *Gradient) String() string            { return proto.CompactTextString(m) }
+func (*Gradient) ProtoMessage()               {}
+func (*Gradient) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Gradient) GetKind() GradientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return GradientKind_GRADIENT_KIND_LINEAR
+}
+
+func (m *Gradient) GetStops() []*GradientStop {
+	if m != nil {
+		return m.Stops
+	}
+	return nil
+}
+
+func (m *Gradient) GetAngle() float64 {
+	if m != nil {
+		return m.Angle
+	}
+	return 0
+}
+
+func (m *Gradient) GetCenter() *matcha_layout.Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *Gradient) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+type PathElement struct {
+	Op     PathOp                 `protobuf:"varint,1,opt,name=op,enum=matcha.paint.PathOp" json:"op,omitempty"`
+	Points []*matcha_layout.Point `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
+}
+
+func (m *PathElement) Reset()                    { *m = PathElement{} }
+func (m *PathElement) String() string            { return proto.CompactTextString(m) }
+func (*PathElement) ProtoMessage()               {}
+func (*PathElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *PathElement) GetOp() PathOp {
+	if m != nil {
+		return m.Op
+	}
+	return PathOp_PATH_OP_MOVE
+}
+
+func (m *PathElement) GetPoints() []*matcha_layout.Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type Path struct {
+	Elements []*PathElement `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *Path) Reset()                    { *m = Path{} }
+func (m *Path) String() string            { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()               {}
+func (*Path) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *Path) GetElements() []*PathElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type DrawCommand struct {
+	Op        DrawOp                   `protobuf:"varint,1,opt,name=op,enum=matcha.paint.DrawOp" json:"op,omitempty"`
+	Transform *matcha_layout.Transform `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
+	Path      *Path                    `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	Color     *matcha.Color            `protobuf:"bytes,4,opt,name=color" json:"color,omitempty"`
+	LineWidth float64                  `protobuf:"fixed64,5,opt,name=lineWidth" json:"lineWidth,omitempty"`
+	LineCap   LineCap                  `protobuf:"varint,6,opt,name=lineCap,enum=matcha.paint.LineCap" json:"lineCap,omitempty"`
+	LineJoin  LineJoin                 `protobuf:"varint,7,opt,name=lineJoin,enum=matcha.paint.LineJoin" json:"lineJoin,omitempty"`
+	Text      string                   `protobuf:"bytes,8,opt,name=text" json:"text,omitempty"`
+	Font      *matcha_text.Font        `protobuf:"bytes,9,opt,name=font" json:"font,omitempty"`
+	Point     *matcha_layout.Point     `protobuf:"bytes,10,opt,name=point" json:"point,omitempty"`
+	Image     *matcha1.Image           `protobuf:"bytes,11,opt,name=image" json:"image,omitempty"`
+	Rect      *matcha_layout.Rect      `protobuf:"bytes,12,opt,name=rect" json:"rect,omitempty"`
+}
+
+func (m *DrawCommand) Reset()                    { *m = DrawCommand{} }
+func (m *DrawCommand) String() string            { return proto.CompactTextString(m) }
+func (*DrawCommand) ProtoMessage()               {}
+func (*DrawCommand) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *DrawCommand) GetOp() DrawOp {
+	if m != nil {
+		return m.Op
+	}
+	return DrawOp_DRAW_OP_SAVE
+}
+
+func (m *DrawCommand) GetTransform() *matcha_layout.Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetColor() *matcha.Color {
+	if m != nil {
+		return m.Color
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetLineWidth() float64 {
+	if m != nil {
+		return m.LineWidth
+	}
+	return 0
+}
+
+func (m *DrawCommand) GetLineCap() LineCap {
+	if m != nil {
+		return m.LineCap
+	}
+	return LineCap_LINE_CAP_BUTT
+}
+
+func (m *DrawCommand) GetLineJoin() LineJoin {
+	if m != nil {
+		return m.LineJoin
+	}
+	return LineJoin_LINE_JOIN_MITER
+}
+
+func (m *DrawCommand) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *DrawCommand) GetFont() *matcha_text.Font {
+	if m != nil {
+		return m.Font
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetPoint() *matcha_layout.Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetImage() *matcha1.Image {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *DrawCommand) GetRect() *matcha_layout.Rect {
+	if m != nil {
+		return m.Rect
+	}
+	return nil
+}
+
+type Drawing struct {
+	Commands []*DrawCommand `protobuf:"bytes,1,rep,name=commands" json:"commands,omitempty"`
+}
+
+func (m *Drawing) Reset()                    { *m = Drawing{} }
+func (m *Drawing) String() string            { return proto.CompactTextString(m) }
+func (*Drawing) ProtoMessage()               {}
+func (*Drawing) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *Drawing) GetCommands() []*DrawCommand {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Style)(nil), "matcha.paint.Style")
+	proto.RegisterType((*CornerRadii)(nil), "matcha.paint.CornerRadii")
+	proto.RegisterType((*Border)(nil), "matcha.paint.Border")
+	proto.RegisterType((*Borders)(nil), "matcha.paint.Borders")
+	proto.RegisterType((*Mask)(nil), "matcha.paint.Mask")
+	proto.RegisterType((*GradientStop)(nil), "matcha.paint.GradientStop")
+	proto.RegisterType((*Gradient)(nil), "matcha.paint.Gradient")
+	proto.RegisterType((*PathElement)(nil), "matcha.paint.PathElement")
+	proto.RegisterType((*Path)(nil), "matcha.paint.Path")
+	proto.RegisterType((*DrawCommand)(nil), "matcha.paint.DrawCommand")
+	proto.RegisterType((*Drawing)(nil), "matcha.paint.Drawing")
+	proto.RegisterEnum("matcha.paint.GradientKind", GradientKind_name, GradientKind_value)
+	proto.RegisterEnum("matcha.paint.PathOp", PathOp_name, PathOp_value)
+	proto.RegisterEnum("matcha.paint.DrawOp", DrawOp_name, DrawOp_value)
+	proto.RegisterEnum("matcha.paint.LineCap", LineCap_name, LineCap_value)
+	proto.RegisterEnum("matcha.paint.LineJoin", LineJoin_name, LineJoin_value)
 }
 
 func init() { proto.RegisterFile("gomatcha.io/matcha/pb/paint/paint.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0xfc, 0x30,
-	0x14, 0xc5, 0x69, 0xff, 0xff, 0xfa, 0xd1, 0x99, 0x41, 0x08, 0x82, 0x61, 0x56, 0x75, 0x36, 0x8e,
-	0x9b, 0x16, 0x74, 0xa1, 0xeb, 0x71, 0x2d, 0x96, 0xba, 0x10, 0xdc, 0xa5, 0x49, 0x66, 0x1a, 0xac,
-	0xb9, 0x25, 0x4d, 0x91, 0xbe, 0x8e, 0x8f, 0xe7, 0x53, 0x48, 0x3e, 0x0a, 0x19, 0xe9, 0x26, 0xc9,
-	0x3d, 0xfc, 0x0e, 0xf7, 0xdc, 0xdc, 0xf4, 0xe6, 0x00, 0x9f, 0x44, 0xd3, 0x86, 0xe4, 0x02, 0x0a,
-	0xf7, 0x2a, 0xba, 0xba, 0xe8, 0x88, 0x90, 0xda, 0x9d, 0x79, 0xa7, 0x40, 0x03, 0x5a, 0x7a, 0xcc,
-	0x6a, 0xeb, 0xeb, 0x79, 0x1b, 0x85, 0x16, 0x94, 0x33, 0xac, 0x6f, 0xe7, 0x91, 0x96, 0x8c, 0x30,
-	0x68, 0x7f, 0x39, 0x74, 0xf3, 0x13, 0xa7, 0xc9, 0xab, 0x1e, 0x5b, 0x8e, 0x36, 0xe9, 0x52, 0x2b,
-	0x22, 0xfb, 0x8e, 0x28, 0x2e, 0xe9, 0x88, 0xa3, 0x2c, 0xda, 0x46, 0xd5, 0x91, 0x86, 0x1e, 0xd2,
-	0x8b, 0x9a, 0xd0, 0x8f, 0x83, 0x82, 0x41, 0xb2, 0x27, 0xd3, 0x11, 0xc7, 0x59, 0xb4, 0x5d, 0xdc,
-	0xad, 0x72, 0xdf, 0xd0, 0x8a, 0xd5, 0x5f, 0x0a, 0x15, 0xe9, 0xa2, 0x06, 0xc5, 0xb8, 0x72, 0xa6,
-	0x7f, 0x73, 0xa6, 0x90, 0x40, 0xd9, 0x64, 0x78, 0x13, 0x4c, 0x37, 0xf8, 0xbf, 0x0d, 0x13, 0x4a,
-	0x26, 0x2f, 0x05, 0x25, 0xb9, 0xaa, 0x08, 0x13, 0x43, 0x8f, 0x13, 0x97, 0x37, 0xd4, 0x0c, 0xd3,
-	0x37, 0x84, 0xc1, 0x97, 0x67, 0x4e, 0x1d, 0x13, 0x6a, 0xe8, 0x71, 0x62, 0x5e, 0xf6, 0xfb, 0x9e,
-	0x6b, 0x7c, 0x66, 0xb3, 0x5d, 0x4e, 0xd9, 0xfc, 0x6f, 0x95, 0x20, 0xa4, 0xae, 0x8e, 0x48, 0x33,
-	0x94, 0xab, 0xdd, 0x50, 0xe7, 0xb3, 0x43, 0x05, 0xc4, 0xee, 0xea, 0x3d, 0xb1, 0x3b, 0xfc, 0x8e,
-	0x57, 0xcf, 0x16, 0x2a, 0x4d, 0x55, 0xee, 0xea, 0x13, 0xbb, 0x8c, 0xfb, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x37, 0x13, 0x97, 0x36, 0x13, 0x02, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x6b, 0x6e, 0xe3, 0x36,
+	0x10, 0x8e, 0x1e, 0x7e, 0x64, 0xec, 0x24, 0x5a, 0x6e, 0x9a, 0xaa, 0xc1, 0xa2, 0x70, 0xd5, 0xdd,
+	0xae, 0x6b, 0x14, 0x4e, 0x91, 0xa2, 0x0f, 0xa0, 0x28, 0x50, 0x3f, 0x94, 0xd4, 0x1b, 0xc7, 0xf6,
+	0xd2, 0xce, 0x6e, 0xd1, 0x3f, 0x06, 0x63, 0x29, 0xb6, 0x10, 0x5b, 0x14, 0x24, 0x06, 0x69, 0x0e,
+	0xd0, 0x7b, 0x14, 0xbd, 0x43, 0xef, 0xd0, 0x1b, 0xf4, 0x1a, 0x3d, 0x42, 0x41, 0x52, 0xb2, 0x25,
+	0xc7, 0x31, 0xf6, 0x4f, 0xac, 0xf9, 0xe6, 0x1b, 0x72, 0x66, 0xf8, 0x71, 0x18, 0x78, 0x3d, 0xa5,
+	0x0b, 0xc2, 0x26, 0x33, 0x52, 0xf7, 0xe8, 0x89, 0xfc, 0x3a, 0x09, 0xae, 0x4f, 0x02, 0xe2, 0xf9,
+	0x4c, 0xfe, 0xad, 0x07, 0x21, 0x65, 0x14, 0x95, 0x63, 0x9a, 0xc0, 0x8e, 0x3f, 0xdb, 0x1c, 0x36,
+	0xa1, 0x73, 0x1a, 0xca, 0x80, 0xa7, 0x28, 0xde, 0x82, 0x4c, 0xdd, 0x98, 0xf2, 0xe5, 0x66, 0xca,
+	0x9c, 0x3c, 0xd0, 0x3b, 0x16, 0xff, 0xc4, 0xd4, 0x57, 0x9b, 0xa9, 0xcc, 0xfd, 0x9d, 0x89, 0x3f,
+	0x92, 0x66, 0xfd, 0x9b, 0x83, 0xdc, 0x90, 0x3d, 0xcc, 0x5d, 0x64, 0x41, 0x99, 0x85, 0xc4, 0x8f,
+	0x02, 0x12, 0xba, 0xfe, 0xe4, 0xc1, 0x54, 0x2a, 0x4a, 0x55, 0xc1, 0x19, 0x0c, 0x7d, 0x0f, 0x07,
+	0xd7, 0x64, 0x72, 0x3b, 0x0d, 0xe9, 0x9d, 0xef, 0xb4, 0x78, 0xee, 0xa6, 0x5a, 0x51, 0xaa, 0xa5,
+	0xd3, 0xbd, 0x7a, 0xbc, 0x99, 0x00, 0xf1, 0x3a, 0x0b, 0x9d, 0x40, 0xe9, 0x9a, 0x86, 0x8e, 0x1b,
+	0xca, 0x20, 0x6d, 0x53, 0x50, 0x9a, 0x81, 0x2a, 0x49, 0xc0, 0x7b, 0xcf, 0x61, 0x33, 0x53, 0x17,
+	0xc9, 0xa4, 0x21, 0x9e, 0xef, 0x84, 0x86, 0xbe, 0x1b, 0x62, 0xe2, 0x78, 0x77, 0x91, 0x99, 0x93,
+	0xf9, 0xa6, 0x31, 0xce, 0x89, 0x66, 0xc4, 0xa1, 0xf7, 0x31, 0xa7, 0x20, 0x39, 0x69, 0x0c, 0xfd,
+	0x90, 0x70, 0xfa, 0x37, 0x37, 0x91, 0xcb, 0xcc, 0xa2, 0xc8, 0xed, 0x30, 0xc9, 0x2d, 0x6e, 0xea,
+	0x80, 0x7a, 0x3e, 0xc3, 0x19, 0x26, 0x2f, 0x4a, 0xda, 0xb2, 0xa8, 0xdd, 0x8d, 0x45, 0xa5, 0x18,
+	0xe8, 0x3b, 0xd8, 0x15, 0xed, 0xbc, 0xa1, 0xe1, 0xc2, 0x04, 0x41, 0x37, 0xd7, 0xf6, 0x19, 0x25,
+	0x7e, 0xbc, 0xa2, 0xa2, 0x33, 0x40, 0xab, 0x86, 0x9e, 0x87, 0xc4, 0xf1, 0x5c, 0x9f, 0x99, 0x25,
+	0xb1, 0xc0, 0x51, 0x3d, 0xad, 0xb3, 0x7a, 0xe2, 0xc5, 0x1b, 0x22, 0xd0, 0x09, 0x14, 0x9c, 0x90,
+	0xdc, 0x7b, 0xfe, 0xd4, 0x2c, 0x8b, 0xe0, 0x8f, 0xb2, 0xc1, 0x6d, 0xe9, 0xc4, 0x09, 0x0b, 0xfd,
+	0x08, 0xa5, 0x55, 0x3f, 0x3d, 0x73, 0x4f, 0x04, 0x7d, 0x92, 0x0d, 0x6a, 0xad, 0x08, 0x38, 0xcd,
+	0x46, 0x2f, 0x61, 0x6f, 0x32, 0xf7, 0x82, 0xa8, 0x35, 0xf3, 0xe6, 0x4e, 0xe8, 0xfa, 0xe6, 0x7e,
+	0x45, 0xa9, 0x16, 0x71, 0x16, 0xe4, 0x39, 0xc9, 0x53, 0x8d, 0xcc, 0x83, 0x4d, 0x39, 0x35, 0xa5,
+	0x13, 0x27, 0x2c, 0xf4, 0x05, 0xe8, 0x0b, 0x12, 0xdd, 0x9a, 0x86, 0x60, 0xa3, 0x2c, 0xfb, 0x92,
+	0x44, 0xb7, 0x58, 0xf8, 0xad, 0x3f, 0x14, 0x28, 0xa5, 0x72, 0x43, 0x26, 0x14, 0x18, 0x0d, 0xba,
+	0xee, 0x0d, 0x8b, 0xa5, 0x9d, 0x98, 0xe8, 0x18, 0x8a, 0x8c, 0x06, 0xd8, 0x9b, 0xce, 0x98, 0x90,
+	0xb3, 0x82, 0x97, 0xb6, 0xd4, 0x21, 0x63, 0x74, 0x21, 0xdd, 0x5a, 0xa2, 0xc3, 0x25, 0x84, 0x3e,
+	0x05, 0x90, 0xa6, 0x58, 0x5a, 0x0a, 0x35, 0x85, 0x58, 0x77, 0x90, 0x97, 0x35, 0xa0, 0xcf, 0x21,
+	0x27, 0xee, 0xbb, 0xa9, 0x6c, 0x52, 0x8a, 0xf4, 0xa1, 0x43, 0xc8, 0xdd, 0x0b, 0xc9, 0xcb, 0x4c,
+	0xa4, 0x81, 0x10, 0xe8, 0x0e, 0x89, 0x66, 0xa6, 0x56, 0xd1, 0xaa, 0x0a, 0x16, 0xdf, 0xe8, 0x05,
+	0xec, 0xf2, 0xdf, 0xc1, 0x8c, 0x44, 0x6e, 0xbc, 0xef, 0x0a, 0xb0, 0xfe, 0x56, 0xa0, 0xd0, 0x5c,
+	0xb6, 0x4c, 0x63, 0x34, 0x30, 0x95, 0xac, 0xb2, 0xd3, 0xfd, 0xc5, 0x9c, 0x80, 0xaa, 0xa0, 0xcf,
+	0x79, 0x11, 0xea, 0x16, 0xa2, 0x60, 0xa0, 0xaf, 0x20, 0x2f, 0x4b, 0x34, 0xb5, 0x2d, 0xdc, 0x98,
+	0x83, 0x6a, 0x90, 0x0b, 0x45, 0xfb, 0xf4, 0x2d, 0x64, 0x49, 0xb1, 0x86, 0xa0, 0xf3, 0x43, 0xe4,
+	0xcd, 0x12, 0x93, 0x6f, 0xbd, 0x59, 0x1d, 0x0e, 0x62, 0xe9, 0xe3, 0x5a, 0x08, 0x48, 0xdc, 0xab,
+	0x47, 0x5a, 0x18, 0x10, 0x36, 0xc3, 0xc2, 0x6f, 0xf5, 0xa1, 0x9c, 0x5c, 0x82, 0x21, 0x2f, 0xf4,
+	0x18, 0x8a, 0x73, 0x3a, 0x21, 0xcc, 0xa3, 0x7e, 0x2c, 0x86, 0xa5, 0xbd, 0x3a, 0x25, 0xf5, 0xe9,
+	0x53, 0xb2, 0xfe, 0x51, 0xa0, 0xb8, 0xbc, 0x56, 0x75, 0xd0, 0x6f, 0x3d, 0xdf, 0x11, 0x2b, 0xed,
+	0x9f, 0x1e, 0x6f, 0xbe, 0x90, 0x17, 0x9e, 0xef, 0x60, 0xc1, 0x43, 0x5f, 0x43, 0x2e, 0x62, 0x34,
+	0x88, 0x4c, 0xb5, 0xa2, 0x55, 0x4b, 0x4f, 0x05, 0xf0, 0x44, 0xb1, 0x24, 0x72, 0x51, 0x10, 0x7f,
+	0x3a, 0x77, 0x63, 0xfd, 0x49, 0x83, 0x1f, 0xc2, 0xc4, 0xf5, 0x99, 0x1b, 0xae, 0xf7, 0x35, 0x33,
+	0xb3, 0x62, 0x0e, 0x3a, 0x82, 0x7c, 0x98, 0x9e, 0x94, 0xb1, 0x65, 0x11, 0x28, 0xf1, 0x4e, 0xd9,
+	0x73, 0x77, 0xc1, 0x8b, 0x79, 0x09, 0x6a, 0x2c, 0x95, 0xfd, 0xf5, 0x83, 0xe2, 0xb4, 0x7e, 0x80,
+	0x55, 0x1a, 0xf0, 0xad, 0x03, 0xbe, 0x7a, 0x52, 0xc3, 0x13, 0x5b, 0x4b, 0x8e, 0xf5, 0x13, 0xe8,
+	0x3c, 0x16, 0x7d, 0x0b, 0x45, 0x57, 0x6e, 0x13, 0x99, 0x4a, 0x45, 0x7b, 0x3c, 0x4b, 0x52, 0x89,
+	0xe0, 0x25, 0xd5, 0xfa, 0x4f, 0x83, 0x12, 0x1f, 0x4d, 0x2d, 0xba, 0x58, 0x10, 0xdf, 0xd9, 0x96,
+	0x22, 0xa7, 0xc5, 0x29, 0x66, 0x86, 0xad, 0xfa, 0xe1, 0xc3, 0x36, 0xd1, 0x94, 0xb6, 0x5d, 0x53,
+	0x2b, 0x9d, 0xe8, 0x5b, 0x6e, 0xf3, 0x0b, 0xd8, 0x9d, 0x7b, 0xbe, 0x2b, 0x1f, 0x31, 0xd9, 0xf7,
+	0x15, 0xc0, 0x67, 0x1f, 0x37, 0x5a, 0x24, 0x30, 0xf3, 0xa2, 0x9a, 0xb5, 0xd9, 0xd7, 0x95, 0x4e,
+	0x9c, 0xb0, 0xd0, 0x29, 0x14, 0xf9, 0xe7, 0x1b, 0xea, 0xf9, 0xe2, 0x2d, 0xdb, 0x3f, 0x3d, 0x7a,
+	0x1c, 0xc1, 0xbd, 0x78, 0xc9, 0xe3, 0xa3, 0x83, 0xbf, 0xf7, 0xe2, 0x5d, 0xdb, 0xc5, 0xe2, 0x1b,
+	0xbd, 0x02, 0xfd, 0x86, 0xfa, 0x2c, 0x7e, 0xb2, 0x9e, 0x25, 0x6b, 0x70, 0x5f, 0xfd, 0x8c, 0xfa,
+	0x0c, 0x0b, 0x37, 0xbf, 0xb7, 0xe2, 0x04, 0xe3, 0xb7, 0x6a, 0xf3, 0x21, 0x4b, 0xca, 0xea, 0xbe,
+	0x96, 0xb6, 0xdc, 0xd7, 0xd7, 0xa0, 0x87, 0xee, 0x84, 0xc5, 0xaf, 0xcf, 0xf3, 0xb5, 0xf5, 0xb0,
+	0x3b, 0x61, 0x58, 0x10, 0xac, 0x9f, 0xa1, 0x10, 0x3f, 0x46, 0x5c, 0x34, 0x13, 0x79, 0xf0, 0x4f,
+	0x88, 0x26, 0x25, 0x0d, 0xbc, 0xa4, 0xd6, 0x9a, 0x50, 0x4e, 0x5f, 0x3d, 0x64, 0xc2, 0xe1, 0x39,
+	0x6e, 0xb4, 0x3b, 0x76, 0x6f, 0x34, 0xbe, 0xe8, 0xf4, 0xda, 0xe3, 0x6e, 0xa7, 0x67, 0x37, 0xb0,
+	0xb1, 0xf3, 0xd8, 0xc3, 0x8d, 0x46, 0xd7, 0x50, 0x6a, 0x0e, 0xe4, 0xa5, 0xe6, 0x91, 0x01, 0xe5,
+	0x41, 0x63, 0xf4, 0xcb, 0xb8, 0x3f, 0x18, 0x5f, 0xf6, 0xdf, 0xd9, 0xc6, 0x4e, 0x1a, 0xe1, 0x2b,
+	0x19, 0x4a, 0x1a, 0x79, 0x7b, 0xd5, 0x68, 0x1b, 0x2a, 0x7a, 0x06, 0x7b, 0x09, 0xd2, 0xba, 0x6a,
+	0x76, 0x5a, 0x86, 0x96, 0x81, 0xba, 0xfd, 0xa1, 0x6d, 0xe8, 0xb5, 0x3f, 0x15, 0xc8, 0x4b, 0xdd,
+	0xf2, 0x25, 0xda, 0xb8, 0xf1, 0x9e, 0x7b, 0x87, 0x0d, 0xb1, 0xcd, 0x73, 0x38, 0x48, 0x10, 0x6c,
+	0x0f, 0x47, 0x7d, 0xcc, 0x77, 0x42, 0xb0, 0x9f, 0x80, 0xad, 0x7e, 0xaf, 0xd5, 0x18, 0x19, 0x6a,
+	0x3a, 0xb4, 0xd5, 0xed, 0x0c, 0x0c, 0x2d, 0x8d, 0x9c, 0x75, 0xba, 0x5d, 0x43, 0x4f, 0xc7, 0x0d,
+	0x47, 0xb8, 0x7f, 0x61, 0x1b, 0xb9, 0x34, 0x6b, 0x64, 0xff, 0x3a, 0x32, 0xf2, 0x3c, 0xc5, 0x04,
+	0xe9, 0x5c, 0x36, 0xce, 0x6d, 0xa3, 0x50, 0xb3, 0xa1, 0x10, 0x6b, 0x91, 0x7b, 0x79, 0xbd, 0xe3,
+	0x56, 0x63, 0x30, 0x6e, 0x5e, 0x8d, 0x46, 0xc6, 0x0e, 0x5f, 0x76, 0x09, 0xe1, 0xfe, 0x55, 0xaf,
+	0x6d, 0x28, 0x3c, 0xef, 0x25, 0x36, 0x7c, 0x7b, 0xd5, 0xc0, 0xb6, 0xa1, 0xd6, 0x3a, 0x50, 0x4c,
+	0x04, 0xba, 0x24, 0xbc, 0xe9, 0x77, 0x7a, 0xe3, 0xcb, 0xce, 0xc8, 0xc6, 0xc6, 0x4e, 0x16, 0x5c,
+	0x5f, 0x4a, 0x80, 0x4d, 0xfb, 0x9d, 0xdd, 0x35, 0xd4, 0xe6, 0xc7, 0xbf, 0xe5, 0xc4, 0xe9, 0xff,
+	0xa5, 0xee, 0x5d, 0x0a, 0x31, 0x0c, 0xb8, 0x35, 0x68, 0x5e, 0xe7, 0xc5, 0xff, 0xb5, 0xdf, 0xfc,
+	0x3f, 0x00, 0x96, 0x56, 0xc7, 0xe3, 0xa8, 0x0b, 0x00, 0x00,
 }
@@ -16,6 +16,8 @@ It has these top-level messages:
 	TapEvent
 	PressRecognizer
 	PressEvent
+	PanRecognizer
+	PanEvent
 */
 package touch
 
@@ -65,6 +67,30 @@ func (x EventKind) String() string {
 }
 func (EventKind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+type PanAxis int32
+
+const (
+	PanAxis_PAN_AXIS_ANY        PanAxis = 0
+	PanAxis_PAN_AXIS_HORIZONTAL PanAxis = 1
+	PanAxis_PAN_AXIS_VERTICAL   PanAxis = 2
+)
+
+var PanAxis_name = map[int32]string{
+	0: "PAN_AXIS_ANY",
+	1: "PAN_AXIS_HORIZONTAL",
+	2: "PAN_AXIS_VERTICAL",
+}
+var PanAxis_value = map[string]int32{
+	"PAN_AXIS_ANY":        0,
+	"PAN_AXIS_HORIZONTAL": 1,
+	"PAN_AXIS_VERTICAL":   2,
+}
+
+func (x PanAxis) String() string {
+	return proto.EnumName(PanAxis_name, int32(x))
+}
+func (PanAxis) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 type Recognizer struct {
 	Id         int64                `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Recognizer *google_protobuf.Any `protobuf:"bytes,3,opt,name=recognizer" json:"recognizer,omitempty"`
@@ -281,6 +307,102 @@ func (m *PressEvent) GetDuration() *google_protobuf1.Duration {
 	return nil
 }
 
+type PanRecognizer struct {
+	FuncId     int64   `protobuf:"varint,1,opt,name=funcId" json:"funcId,omitempty"`
+	MinTouches int64   `protobuf:"varint,2,opt,name=minTouches" json:"minTouches,omitempty"`
+	MaxTouches int64   `protobuf:"varint,3,opt,name=maxTouches" json:"maxTouches,omitempty"`
+	Axis       PanAxis `protobuf:"varint,4,opt,name=axis,enum=matcha.touch.PanAxis" json:"axis,omitempty"`
+}
+
+func (m *PanRecognizer) Reset()                    { *m = PanRecognizer{} }
+func (m *PanRecognizer) String() string            { return proto.CompactTextString(m) }
+func (*PanRecognizer) ProtoMessage()               {}
+func (*PanRecognizer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *PanRecognizer) GetFuncId() int64 {
+	if m != nil {
+		return m.FuncId
+	}
+	return 0
+}
+
+func (m *PanRecognizer) GetMinTouches() int64 {
+	if m != nil {
+		return m.MinTouches
+	}
+	return 0
+}
+
+func (m *PanRecognizer) GetMaxTouches() int64 {
+	if m != nil {
+		return m.MaxTouches
+	}
+	return 0
+}
+
+func (m *PanRecognizer) GetAxis() PanAxis {
+	if m != nil {
+		return m.Axis
+	}
+	return PanAxis_PAN_AXIS_ANY
+}
+
+type PanEvent struct {
+	Timestamp   *google_protobuf2.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Kind        EventKind                   `protobuf:"varint,2,opt,name=kind,enum=matcha.touch.EventKind" json:"kind,omitempty"`
+	Position    *matcha_layout.Point        `protobuf:"bytes,3,opt,name=position" json:"position,omitempty"`
+	Translation *matcha_layout.Point        `protobuf:"bytes,4,opt,name=translation" json:"translation,omitempty"`
+	Velocity    *matcha_layout.Point        `protobuf:"bytes,5,opt,name=velocity" json:"velocity,omitempty"`
+	Touches     int64                       `protobuf:"varint,6,opt,name=touches" json:"touches,omitempty"`
+}
+
+func (m *PanEvent) Reset()                    { *m = PanEvent{} }
+func (m *PanEvent) String() string            { return proto.CompactTextString(m) }
+func (*PanEvent) ProtoMessage()               {}
+func (*PanEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *PanEvent) GetTimestamp() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PanEvent) GetKind() EventKind {
+	if m != nil {
+		return m.Kind
+	}
+	return EventKind_EVENT_KIND_POSSIBLE
+}
+
+func (m *PanEvent) GetPosition() *matcha_layout.Point {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (m *PanEvent) GetTranslation() *matcha_layout.Point {
+	if m != nil {
+		return m.Translation
+	}
+	return nil
+}
+
+func (m *PanEvent) GetVelocity() *matcha_layout.Point {
+	if m != nil {
+		return m.Velocity
+	}
+	return nil
+}
+
+func (m *PanEvent) GetTouches() int64 {
+	if m != nil {
+		return m.Touches
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Recognizer)(nil), "matcha.touch.Recognizer")
 	proto.RegisterType((*RecognizerList)(nil), "matcha.touch.RecognizerList")
@@ -290,46 +412,59 @@ func init() {
 	proto.RegisterType((*TapEvent)(nil), "matcha.touch.TapEvent")
 	proto.RegisterType((*PressRecognizer)(nil), "matcha.touch.PressRecognizer")
 	proto.RegisterType((*PressEvent)(nil), "matcha.touch.PressEvent")
+	proto.RegisterType((*PanRecognizer)(nil), "matcha.touch.PanRecognizer")
+	proto.RegisterType((*PanEvent)(nil), "matcha.touch.PanEvent")
 	proto.RegisterEnum("matcha.touch.EventKind", EventKind_name, EventKind_value)
+	proto.RegisterEnum("matcha.touch.PanAxis", PanAxis_name, PanAxis_value)
 }
 
 func init() { proto.RegisterFile("gomatcha.io/matcha/pb/touch/touch2.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xc5, 0x71, 0x5b, 0xd2, 0x31, 0x09, 0x61, 0x69, 0x1b, 0x27, 0x07, 0x88, 0x2c, 0x84, 0x0c,
-	0x48, 0x0e, 0x0a, 0x20, 0x21, 0x38, 0x25, 0x8d, 0x5b, 0xa2, 0xa6, 0x69, 0xb4, 0x89, 0x38, 0xf4,
-	0x52, 0x39, 0xb6, 0x93, 0xae, 0x48, 0x76, 0x2d, 0x7b, 0x8d, 0x14, 0xbe, 0x81, 0xaf, 0x80, 0xdf,
-	0xe2, 0x63, 0x50, 0xd6, 0x1b, 0x67, 0x9b, 0x22, 0x84, 0xc4, 0xa5, 0x17, 0xdb, 0x33, 0xef, 0xed,
-	0xcc, 0x7b, 0xb3, 0x63, 0xb0, 0x67, 0x6c, 0xe1, 0x71, 0xff, 0xda, 0x73, 0x08, 0x6b, 0x66, 0x5f,
-	0xcd, 0x68, 0xd2, 0xe4, 0x2c, 0xf5, 0xaf, 0xb3, 0x67, 0xcb, 0x89, 0x62, 0xc6, 0x19, 0x7a, 0x20,
-	0x79, 0x22, 0x59, 0xaf, 0xcd, 0x18, 0x9b, 0xcd, 0xc3, 0xa6, 0xc0, 0x26, 0xe9, 0xb4, 0xe9, 0xd1,
-	0x65, 0x46, 0xac, 0x3f, 0xd9, 0x86, 0x82, 0x34, 0xf6, 0x38, 0x61, 0x54, 0xe2, 0x4f, 0xb7, 0x71,
-	0x4e, 0x16, 0x61, 0xc2, 0xbd, 0x45, 0x24, 0x09, 0x2f, 0xfe, 0xac, 0x69, 0xee, 0x2d, 0x59, 0xca,
-	0xe5, 0x2b, 0xa3, 0x5a, 0x18, 0x00, 0x87, 0x3e, 0x9b, 0x51, 0xf2, 0x2d, 0x8c, 0x51, 0x19, 0x0a,
-	0x24, 0x30, 0xb5, 0x86, 0x66, 0xeb, 0xb8, 0x40, 0x02, 0xf4, 0x16, 0x20, 0xce, 0x51, 0x53, 0x6f,
-	0x68, 0xb6, 0xd1, 0x3a, 0x70, 0xb2, 0xf6, 0xce, 0xba, 0xbd, 0xd3, 0xa6, 0x4b, 0xac, 0xf0, 0xac,
-	0x3e, 0x94, 0x37, 0x35, 0xfb, 0x24, 0xe1, 0xe8, 0x03, 0x18, 0x1b, 0x3c, 0x31, 0xb5, 0x86, 0x6e,
-	0x1b, 0x2d, 0xd3, 0x51, 0x07, 0xe2, 0x6c, 0x8e, 0x60, 0x95, 0x6c, 0x61, 0xa8, 0x74, 0x52, 0xce,
-	0x19, 0x55, 0x74, 0x9a, 0x70, 0x9f, 0x51, 0xf7, 0x6b, 0x48, 0xb9, 0x14, 0xbb, 0x0e, 0xd1, 0x33,
-	0x28, 0x91, 0x19, 0x65, 0x71, 0x98, 0x8c, 0xfc, 0x98, 0xcd, 0xe7, 0x66, 0xa1, 0xa1, 0xd9, 0x45,
-	0x7c, 0x33, 0x69, 0x7d, 0xd7, 0xc0, 0xc8, 0x8a, 0x66, 0xa7, 0xde, 0xc3, 0x7e, 0x3e, 0x43, 0x51,
-	0xd1, 0x68, 0xd5, 0x6f, 0xd9, 0x1c, 0xaf, 0x19, 0x78, 0x43, 0x46, 0x47, 0xb0, 0x47, 0x68, 0x42,
-	0x82, 0x50, 0x4c, 0xa7, 0x88, 0x65, 0x84, 0x5e, 0xc1, 0xce, 0x17, 0x42, 0x03, 0x73, 0xa7, 0xa1,
-	0xd9, 0xe5, 0x56, 0xf5, 0xa6, 0x55, 0xd1, 0xf4, 0x8c, 0xd0, 0x00, 0x0b, 0x92, 0x75, 0x0e, 0xa5,
-	0xb1, 0x17, 0x29, 0xfe, 0x0e, 0x60, 0xd7, 0x67, 0x69, 0xee, 0x2e, 0x0b, 0xd0, 0x73, 0x28, 0xe7,
-	0x83, 0x09, 0x4e, 0x52, 0xea, 0x0b, 0x73, 0x3a, 0xde, 0xca, 0x5a, 0x3f, 0x35, 0x28, 0x8e, 0xbd,
-	0xe8, 0x7f, 0xad, 0xbd, 0x86, 0x62, 0xc4, 0x12, 0xb2, 0x5a, 0x3c, 0xd1, 0x68, 0x75, 0xf5, 0xd2,
-	0x86, 0x5c, 0xa1, 0x21, 0x23, 0x94, 0xe3, 0x9c, 0x95, 0x9b, 0xd6, 0xff, 0xc5, 0xf4, 0x14, 0x1e,
-	0x0e, 0xe3, 0x30, 0x49, 0x14, 0xdb, 0x1f, 0xc1, 0x58, 0x10, 0xda, 0x95, 0xdb, 0x2e, 0xd5, 0xd6,
-	0x6e, 0xa9, 0x5d, 0x13, 0xb0, 0xca, 0x5e, 0xdd, 0xc4, 0x34, 0xa5, 0x7e, 0x2f, 0x90, 0x53, 0x91,
-	0x91, 0xf5, 0x4b, 0x03, 0x10, 0x8d, 0xee, 0xf6, 0x3c, 0xd0, 0x3b, 0x28, 0xae, 0xff, 0x73, 0xb1,
-	0x35, 0x7f, 0x75, 0x9e, 0x53, 0x5f, 0x52, 0xd8, 0xcf, 0x2b, 0xa1, 0x2a, 0x3c, 0x76, 0x3f, 0xbb,
-	0x83, 0xf1, 0xd5, 0x59, 0x6f, 0xd0, 0xbd, 0x1a, 0x5e, 0x8c, 0x46, 0xbd, 0x4e, 0xdf, 0xad, 0xdc,
-	0x43, 0x47, 0x80, 0x14, 0xe0, 0xf8, 0x53, 0x7b, 0x70, 0xea, 0x76, 0x2b, 0x1a, 0x3a, 0x84, 0x47,
-	0x4a, 0xfe, 0xa4, 0xdd, 0xeb, 0xbb, 0xdd, 0x4a, 0x01, 0xd5, 0xe0, 0x50, 0x49, 0x63, 0xf7, 0xf8,
-	0xe2, 0x74, 0xd0, 0xbb, 0x74, 0xbb, 0x15, 0xbd, 0x53, 0xbd, 0xdc, 0x15, 0xfa, 0x7f, 0x14, 0x4a,
-	0xe7, 0xc2, 0xce, 0xb0, 0x33, 0x5e, 0xc5, 0x93, 0x3d, 0xa1, 0xf2, 0xcd, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0x6b, 0xc5, 0xfa, 0x11, 0x05, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0xc6, 0x76, 0x80, 0x70, 0x42, 0xb2, 0xde, 0x59, 0x7e, 0x0c, 0x17, 0x6c, 0x64, 0xad, 0x56,
+	0x81, 0x95, 0x92, 0x55, 0x76, 0x5b, 0x55, 0xed, 0x95, 0x43, 0x0c, 0x58, 0x84, 0xc4, 0x9a, 0x44,
+	0xa8, 0xe5, 0x26, 0x32, 0xb6, 0x09, 0xa3, 0x26, 0x33, 0x91, 0x7f, 0x10, 0xe9, 0x33, 0xf4, 0xaa,
+	0x8f, 0xd0, 0x5e, 0xf4, 0xa5, 0xfa, 0x30, 0x55, 0xec, 0xb1, 0x33, 0xfc, 0xa8, 0x45, 0xe2, 0xa6,
+	0x37, 0x90, 0x73, 0xbe, 0x6f, 0xe6, 0x9c, 0xef, 0x3b, 0x67, 0x0c, 0xb5, 0x11, 0x9b, 0x38, 0x91,
+	0x7b, 0xed, 0xd4, 0x09, 0x6b, 0xa4, 0xbf, 0x1a, 0xd3, 0xcb, 0x46, 0xc4, 0x62, 0xf7, 0x3a, 0xfd,
+	0xdb, 0xac, 0x4f, 0x03, 0x16, 0x31, 0xb4, 0xce, 0x79, 0x49, 0x72, 0x77, 0x67, 0xc4, 0xd8, 0x68,
+	0xec, 0x37, 0x12, 0xec, 0x32, 0xbe, 0x6a, 0x38, 0x74, 0x96, 0x12, 0x77, 0xf7, 0xee, 0x43, 0x5e,
+	0x1c, 0x38, 0x11, 0x61, 0x94, 0xe3, 0x7f, 0xde, 0xc7, 0x23, 0x32, 0xf1, 0xc3, 0xc8, 0x99, 0x4c,
+	0x39, 0x61, 0xff, 0xf1, 0x9e, 0xc6, 0xce, 0x8c, 0xc5, 0x11, 0xff, 0x97, 0x52, 0x75, 0x0c, 0x80,
+	0x7d, 0x97, 0x8d, 0x28, 0xf9, 0xe0, 0x07, 0xa8, 0x02, 0x32, 0xf1, 0x34, 0xa9, 0x2a, 0xd5, 0x14,
+	0x2c, 0x13, 0x0f, 0xfd, 0x0f, 0x10, 0xe4, 0xa8, 0xa6, 0x54, 0xa5, 0x5a, 0xa9, 0xb9, 0x51, 0x4f,
+	0xcb, 0xd7, 0xb3, 0xf2, 0x75, 0x83, 0xce, 0xb0, 0xc0, 0xd3, 0x3b, 0x50, 0x59, 0xdc, 0xd9, 0x21,
+	0x61, 0x84, 0x5e, 0x43, 0x69, 0x81, 0x87, 0x9a, 0x54, 0x55, 0x6a, 0xa5, 0xa6, 0x56, 0x17, 0x0d,
+	0xa9, 0x2f, 0x8e, 0x60, 0x91, 0xac, 0x63, 0x50, 0x5b, 0x71, 0x14, 0x31, 0x2a, 0xf4, 0xa9, 0xc1,
+	0x2a, 0xa3, 0xe6, 0x8d, 0x4f, 0x23, 0xde, 0x6c, 0x16, 0xa2, 0xbf, 0xa0, 0x4c, 0x46, 0x94, 0x05,
+	0x7e, 0xd8, 0x77, 0x03, 0x36, 0x1e, 0x6b, 0x72, 0x55, 0xaa, 0x15, 0xf1, 0xdd, 0xa4, 0xfe, 0x51,
+	0x82, 0x52, 0x7a, 0x69, 0x7a, 0xea, 0x15, 0xac, 0xe5, 0x1e, 0x26, 0x37, 0x96, 0x9a, 0xbb, 0x0f,
+	0x64, 0x0e, 0x32, 0x06, 0x5e, 0x90, 0xd1, 0x16, 0xac, 0x10, 0x1a, 0x12, 0xcf, 0x4f, 0xdc, 0x29,
+	0x62, 0x1e, 0xa1, 0x7f, 0xa0, 0xf0, 0x9e, 0x50, 0x4f, 0x2b, 0x54, 0xa5, 0x5a, 0xa5, 0xb9, 0x7d,
+	0x57, 0x6a, 0x52, 0xf4, 0x94, 0x50, 0x0f, 0x27, 0x24, 0xfd, 0x0c, 0xca, 0x03, 0x67, 0x2a, 0xe8,
+	0xdb, 0x80, 0x65, 0x97, 0xc5, 0xb9, 0xba, 0x34, 0x40, 0x7f, 0x43, 0x25, 0x37, 0xc6, 0x3b, 0x8a,
+	0xa9, 0x9b, 0x88, 0x53, 0xf0, 0xbd, 0xac, 0xfe, 0x45, 0x82, 0xe2, 0xc0, 0x99, 0x3e, 0x57, 0xda,
+	0xbf, 0x50, 0x9c, 0xb2, 0x90, 0xcc, 0x17, 0x4f, 0x93, 0xf9, 0xe8, 0xb9, 0x0c, 0xbe, 0x42, 0x36,
+	0x23, 0x34, 0xc2, 0x39, 0x2b, 0x17, 0xad, 0x3c, 0x45, 0xf4, 0x15, 0xfc, 0x66, 0x07, 0x7e, 0x18,
+	0x0a, 0xb2, 0xdf, 0x40, 0x69, 0x42, 0x68, 0x9b, 0x6f, 0x3b, 0xef, 0x76, 0xe7, 0x41, 0xb7, 0x19,
+	0x01, 0x8b, 0xec, 0xf9, 0x24, 0xae, 0x62, 0xea, 0x5a, 0x1e, 0x77, 0x85, 0x47, 0xfa, 0x37, 0x09,
+	0x20, 0x29, 0xf4, 0x6b, 0xfb, 0x81, 0x5e, 0x40, 0x31, 0x7b, 0xe7, 0x5a, 0xe1, 0x67, 0xca, 0x73,
+	0xaa, 0xfe, 0x49, 0x82, 0xb2, 0xed, 0x88, 0x8f, 0x63, 0x61, 0x84, 0x24, 0x1a, 0x81, 0xf6, 0x00,
+	0x26, 0x84, 0x0e, 0xe6, 0xc5, 0xfd, 0x90, 0x9b, 0x24, 0x64, 0x12, 0xdc, 0xb9, 0xcd, 0x70, 0x85,
+	0xe3, 0x79, 0x06, 0xed, 0x43, 0xc1, 0xb9, 0x25, 0x21, 0x5f, 0xe9, 0xcd, 0xbb, 0x6a, 0x6c, 0x87,
+	0x1a, 0xb7, 0x24, 0xc4, 0x09, 0x45, 0xff, 0x2a, 0x43, 0xd1, 0x76, 0x9e, 0xfd, 0xb8, 0x32, 0xff,
+	0xe4, 0xa7, 0xf8, 0x27, 0x8e, 0x47, 0x79, 0xd2, 0x78, 0x5e, 0x42, 0x29, 0x0a, 0x1c, 0x1a, 0x8e,
+	0x45, 0xd3, 0x1f, 0x3f, 0x24, 0x12, 0xe7, 0x95, 0x6e, 0xfc, 0x31, 0x73, 0x49, 0x34, 0xd3, 0x96,
+	0x7f, 0x54, 0x29, 0x63, 0xcd, 0xbf, 0x57, 0x11, 0xf7, 0x75, 0x25, 0xfd, 0x5e, 0xf1, 0xf0, 0x80,
+	0xc2, 0x5a, 0x2e, 0x04, 0x6d, 0xc3, 0x1f, 0xe6, 0xb9, 0xd9, 0x1d, 0x0c, 0x4f, 0xad, 0x6e, 0x7b,
+	0x68, 0xf7, 0xfa, 0x7d, 0xab, 0xd5, 0x31, 0xd5, 0x25, 0xb4, 0x05, 0x48, 0x00, 0x0e, 0x4f, 0x8c,
+	0xee, 0xb1, 0xd9, 0x56, 0x25, 0xb4, 0x09, 0xbf, 0x0b, 0xf9, 0x23, 0xc3, 0xea, 0x98, 0x6d, 0x55,
+	0x46, 0x3b, 0xb0, 0x29, 0xa4, 0xb1, 0x79, 0xd8, 0x3b, 0xee, 0x5a, 0x17, 0x66, 0x5b, 0x55, 0x0e,
+	0x4e, 0x61, 0x95, 0x8f, 0x0a, 0xa9, 0xb0, 0x6e, 0x1b, 0xdd, 0xa1, 0xf1, 0xd6, 0xea, 0x0f, 0x8d,
+	0xee, 0x3b, 0x75, 0x69, 0x5e, 0x3f, 0xcf, 0x9c, 0xf4, 0xb0, 0x75, 0xd1, 0xeb, 0x0e, 0x8c, 0x4e,
+	0x5a, 0x27, 0x07, 0xce, 0x4d, 0x3c, 0xb0, 0x0e, 0x8d, 0x8e, 0x2a, 0xb7, 0xb6, 0x2f, 0x96, 0x13,
+	0x1d, 0x9f, 0xe5, 0xf2, 0x59, 0xa2, 0xdf, 0x6e, 0x25, 0xcb, 0x72, 0xb9, 0x92, 0xcc, 0xf5, 0xbf,
+	0xef, 0x03, 0x00, 0xe9, 0x10, 0xcd, 0x4d, 0x1d, 0x07, 0x00, 0x00,
 }
@@ -17,6 +17,7 @@ package view
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import matcha_layout "gomatcha.io/matcha/pb/layout"
 import matcha_paint "gomatcha.io/matcha/pb/paint"
 import google_protobuf "github.com/golang/protobuf/ptypes/any"
 
@@ -101,13 +102,15 @@ type LayoutPaintNode struct {
 	PaintId  int64 `protobuf:"varint,3,opt,name=paintId" json:"paintId,omitempty"`
 	// matcha.layout.Guide layoutGuide = 4;
 	// Guide
-	Minx       float64             `protobuf:"fixed64,4,opt,name=minx" json:"minx,omitempty"`
-	Miny       float64             `protobuf:"fixed64,5,opt,name=miny" json:"miny,omitempty"`
-	Maxx       float64             `protobuf:"fixed64,6,opt,name=maxx" json:"maxx,omitempty"`
-	Maxy       float64             `protobuf:"fixed64,7,opt,name=maxy" json:"maxy,omitempty"`
-	ZIndex     int64               `protobuf:"varint,8,opt,name=zIndex" json:"zIndex,omitempty"`
-	ChildOrder []int64             `protobuf:"varint,9,rep,packed,name=childOrder" json:"childOrder,omitempty"`
-	PaintStyle *matcha_paint.Style `protobuf:"bytes,10,opt,name=paintStyle" json:"paintStyle,omitempty"`
+	Minx       float64 `protobuf:"fixed64,4,opt,name=minx" json:"minx,omitempty"`
+	Miny       float64 `protobuf:"fixed64,5,opt,name=miny" json:"miny,omitempty"`
+	Maxx       float64 `protobuf:"fixed64,6,opt,name=maxx" json:"maxx,omitempty"`
+	Maxy       float64 `protobuf:"fixed64,7,opt,name=maxy" json:"maxy,omitempty"`
+	ZIndex     int64   `protobuf:"varint,8,opt,name=zIndex" json:"zIndex,omitempty"`
+	ChildOrder []int64 `protobuf:"varint,9,rep,packed,name=childOrder" json:"childOrder,omitempty"`
+	// Transform is applied before the paintStyle's transform. It is not applied by the iOS client yet.
+	Transform  *matcha_layout.Transform `protobuf:"bytes,11,opt,name=transform" json:"transform,omitempty"`
+	PaintStyle *matcha_paint.Style      `protobuf:"bytes,10,opt,name=paintStyle" json:"paintStyle,omitempty"`
 }
 
 func (m *LayoutPaintNode) Reset()                    { *m = LayoutPaintNode{} }
@@ -178,6 +181,13 @@ func (m *LayoutPaintNode) GetChildOrder() []int64 {
 	return nil
 }
 
+func (m *LayoutPaintNode) GetTransform() *matcha_layout.Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
 func (m *LayoutPaintNode) GetPaintStyle() *matcha_paint.Style {
 	if m != nil {
 		return m.PaintStyle
@@ -226,41 +236,43 @@ func init() {
 func init() { proto.RegisterFile("gomatcha.io/matcha/pb/view/view.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xbd, 0x69, 0x7e, 0xc6, 0x88, 0x54, 0x4b, 0x5b, 0x2d, 0x16, 0x42, 0x21, 0x12, 0x6a,
-	0x84, 0x90, 0x23, 0xa5, 0x12, 0x82, 0xde, 0x12, 0x89, 0x43, 0x24, 0xfa, 0x23, 0x47, 0xf4, 0xc0,
-	0x6d, 0xdd, 0x5d, 0xd2, 0x15, 0x8e, 0x1d, 0x39, 0x4e, 0x63, 0xf3, 0x1c, 0x3c, 0x01, 0x77, 0xde,
-	0x8d, 0x47, 0x40, 0x3b, 0x76, 0xcc, 0x26, 0x75, 0xb9, 0x70, 0xb1, 0x76, 0x3e, 0x7f, 0xf3, 0xcd,
-	0xec, 0x7c, 0xb3, 0xf0, 0x7a, 0x1e, 0x2f, 0x78, 0x7a, 0x7b, 0xc7, 0x3d, 0x15, 0x0f, 0x8b, 0xd3,
-	0x70, 0x19, 0x0c, 0xef, 0x95, 0xdc, 0xe0, 0xc7, 0x5b, 0x26, 0x71, 0x1a, 0x53, 0xa7, 0x24, 0x69,
-	0xc8, 0x3d, 0xad, 0xcf, 0x59, 0x72, 0x15, 0xa5, 0xc5, 0xb7, 0xc8, 0x72, 0x9f, 0xcf, 0xe3, 0x78,
-	0x1e, 0xca, 0x21, 0x46, 0xc1, 0xfa, 0xeb, 0x90, 0x47, 0x79, 0xf1, 0xab, 0xff, 0x8b, 0x40, 0x67,
-	0xb2, 0x56, 0xa1, 0xb8, 0x8c, 0x85, 0xa4, 0x4f, 0xc1, 0x56, 0x82, 0x59, 0x3d, 0x6b, 0x40, 0x7c,
-	0x5b, 0x09, 0xca, 0xa0, 0x15, 0xe8, 0x9f, 0x53, 0xc1, 0x6c, 0x04, 0xb7, 0x21, 0x7d, 0x09, 0x10,
-	0x24, 0x4a, 0xcc, 0xe5, 0x25, 0x5f, 0x48, 0x46, 0x7a, 0xd6, 0xa0, 0xe3, 0x1b, 0x08, 0x7d, 0x07,
-	0x4e, 0x11, 0xdd, 0xf0, 0x70, 0x2d, 0x59, 0xa3, 0x67, 0x0d, 0x9c, 0xd1, 0x91, 0x57, 0x34, 0xe2,
-	0x6d, 0x1b, 0xf1, 0xc6, 0x51, 0xee, 0x9b, 0x44, 0x7a, 0x0e, 0xcd, 0x7b, 0x7d, 0x58, 0xb1, 0x83,
-	0x1e, 0x19, 0x38, 0xa3, 0xbe, 0x67, 0xdc, 0xd8, 0xab, 0x3a, 0xf5, 0x90, 0xbd, 0xfa, 0x18, 0xa5,
-	0x49, 0xee, 0x97, 0x19, 0xd4, 0x85, 0xf6, 0xed, 0x9d, 0x0a, 0x45, 0x22, 0x23, 0xd6, 0xec, 0x91,
-	0x01, 0xf1, 0xab, 0x58, 0xeb, 0xf2, 0x30, 0x9d, 0x8a, 0x15, 0x6b, 0xfd, 0x53, 0x77, 0x8c, 0xa4,
-	0x52, 0xb7, 0xc8, 0x70, 0xaf, 0xc0, 0x31, 0xca, 0xd1, 0x43, 0x20, 0xdf, 0x64, 0x8e, 0x53, 0xea,
-	0xf8, 0xfa, 0x48, 0xdf, 0xc0, 0x01, 0xb6, 0x80, 0x43, 0x7a, 0xec, 0x9a, 0x05, 0xe5, 0xdc, 0x7e,
-	0x6f, 0xb9, 0x1f, 0xc0, 0x31, 0xea, 0x98, 0x82, 0xa4, 0x10, 0x3c, 0x32, 0x05, 0x89, 0x91, 0xda,
-	0xff, 0x61, 0x43, 0xf7, 0x13, 0xcf, 0xe3, 0x75, 0x7a, 0xad, 0x0d, 0xae, 0x75, 0xcd, 0x85, 0x76,
-	0x88, 0x94, 0xca, 0xb6, 0x2a, 0xd6, 0x8e, 0xe2, 0x66, 0x4c, 0x05, 0x9a, 0x46, 0xfc, 0x6d, 0x48,
-	0x29, 0x34, 0x16, 0x2a, 0xca, 0xd0, 0x2a, 0xcb, 0xc7, 0x73, 0x89, 0xe5, 0xec, 0xa0, 0xc2, 0x72,
-	0xc4, 0x78, 0x96, 0xb1, 0x66, 0x89, 0xf1, 0x2c, 0x2b, 0xb1, 0x9c, 0xb5, 0x2a, 0x2c, 0xa7, 0x27,
-	0xd0, 0xfc, 0x3e, 0x8d, 0x84, 0xcc, 0x58, 0x1b, 0x0b, 0x95, 0x91, 0xde, 0x1c, 0x74, 0xe5, 0x2a,
-	0x11, 0x32, 0x61, 0x1d, 0xf4, 0xc9, 0x40, 0xe8, 0x19, 0x00, 0xb6, 0x34, 0x4b, 0xf3, 0x50, 0x32,
-	0xc0, 0x89, 0x3e, 0xdb, 0xba, 0x55, 0x6c, 0x35, 0xfe, 0xf2, 0x0d, 0x5a, 0xff, 0x37, 0x81, 0x86,
-	0x1f, 0xc7, 0x29, 0x9d, 0xc1, 0x61, 0xb8, 0x3b, 0x9e, 0x15, 0xb3, 0xd1, 0xf1, 0xd3, 0x1d, 0xc7,
-	0x35, 0xd9, 0xdb, 0x1b, 0x64, 0x69, 0xfb, 0x03, 0x01, 0x3a, 0x06, 0x08, 0xb6, 0x1b, 0xb2, 0x62,
-	0x04, 0xe5, 0x5e, 0x3d, 0x94, 0xab, 0xb6, 0xa8, 0x14, 0x32, 0x92, 0xb4, 0xc4, 0x42, 0x09, 0x11,
-	0xca, 0x0d, 0x4f, 0xf4, 0x73, 0x78, 0x44, 0xe2, 0xa2, 0xe2, 0x94, 0x12, 0x7f, 0x93, 0x5c, 0x0e,
-	0xc7, 0xb5, 0x0d, 0xd7, 0xec, 0xcf, 0x68, 0x77, 0x21, 0x5f, 0xec, 0x14, 0xda, 0x13, 0x31, 0x17,
-	0xf3, 0x33, 0x74, 0xf7, 0x2e, 0x51, 0x23, 0xfe, 0x76, 0x57, 0xfc, 0xa4, 0xfe, 0x25, 0x99, 0xb2,
-	0x33, 0xe8, 0xee, 0x5d, 0xec, 0xff, 0x1f, 0xd1, 0xe4, 0xf8, 0x4b, 0x43, 0x57, 0xfc, 0x69, 0x3f,
-	0xb9, 0xc0, 0xfa, 0x37, 0x4a, 0x6e, 0xae, 0x27, 0x41, 0x13, 0xf9, 0x67, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xf6, 0xb7, 0xb1, 0xdd, 0x51, 0x05, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0x56, 0xe2, 0xae, 0x5b, 0x4f, 0x7e, 0xfa, 0x6d, 0x32, 0xdb, 0x64, 0x22, 0x84, 0x4a, 0x25,
+	0xb4, 0x82, 0x50, 0x2a, 0x6d, 0xd2, 0x04, 0xbb, 0xdb, 0x24, 0x2e, 0x2a, 0xb1, 0x3f, 0x4a, 0x61,
+	0x17, 0xdc, 0x39, 0xb3, 0xd7, 0x45, 0xa4, 0x49, 0x95, 0xa4, 0x6b, 0xc2, 0xe3, 0x70, 0xcf, 0x9b,
+	0xf0, 0x30, 0x3c, 0x02, 0xf2, 0x89, 0x93, 0xb9, 0x5d, 0xd8, 0x0d, 0x37, 0x89, 0xcf, 0xf1, 0x77,
+	0xbe, 0x73, 0xec, 0xef, 0x33, 0xbc, 0x9e, 0x26, 0x33, 0x9e, 0xdf, 0xdc, 0x71, 0x2f, 0x4c, 0x46,
+	0xd5, 0x6a, 0x34, 0x0f, 0x46, 0xf7, 0xa1, 0x5c, 0xe2, 0xc7, 0x9b, 0xa7, 0x49, 0x9e, 0x50, 0x47,
+	0x83, 0x54, 0xca, 0x7d, 0xd3, 0x5e, 0x13, 0xf1, 0x32, 0x59, 0xe4, 0xfa, 0x57, 0xd5, 0xb9, 0x07,
+	0xed, 0xd0, 0x39, 0x0f, 0xe3, 0xbc, 0xfa, 0x6a, 0xe0, 0xf3, 0x69, 0x92, 0x4c, 0x23, 0x39, 0xc2,
+	0x28, 0x58, 0xdc, 0x8e, 0x78, 0x5c, 0x56, 0x5b, 0x83, 0x9f, 0x04, 0x7a, 0x67, 0x8b, 0x30, 0x12,
+	0x17, 0x89, 0x90, 0xf4, 0x7f, 0xb0, 0x43, 0xc1, 0xac, 0xbe, 0x35, 0x24, 0xbe, 0x1d, 0x0a, 0xca,
+	0x60, 0x33, 0x50, 0x9b, 0x63, 0xc1, 0x6c, 0x4c, 0xd6, 0x21, 0x7d, 0x09, 0x10, 0xa4, 0xa1, 0x98,
+	0xca, 0x0b, 0x3e, 0x93, 0x8c, 0xf4, 0xad, 0x61, 0xcf, 0x37, 0x32, 0xf4, 0x18, 0x9c, 0x2a, 0xba,
+	0xe6, 0xd1, 0x42, 0xb2, 0x4e, 0xdf, 0x1a, 0x3a, 0x87, 0xbb, 0x5e, 0x35, 0x88, 0x57, 0x0f, 0xe2,
+	0x9d, 0xc6, 0xa5, 0x6f, 0x02, 0xe9, 0x09, 0x74, 0xef, 0xd5, 0x22, 0x63, 0x1b, 0x7d, 0x32, 0x74,
+	0x0e, 0x07, 0x9e, 0x71, 0x39, 0x5e, 0x33, 0xa9, 0x87, 0xe8, 0xec, 0x63, 0x9c, 0xa7, 0xa5, 0xaf,
+	0x2b, 0xa8, 0x0b, 0x5b, 0x37, 0x77, 0x61, 0x24, 0x52, 0x19, 0xb3, 0x6e, 0x9f, 0x0c, 0x89, 0xdf,
+	0xc4, 0x8a, 0x97, 0x47, 0xf9, 0x58, 0x64, 0x6c, 0xf3, 0x49, 0xde, 0x53, 0x04, 0x69, 0xde, 0xaa,
+	0xc2, 0xbd, 0x04, 0xc7, 0x68, 0x47, 0x77, 0x80, 0x7c, 0x93, 0x25, 0xde, 0x52, 0xcf, 0x57, 0x4b,
+	0xfa, 0x16, 0x36, 0x70, 0x04, 0x66, 0x3f, 0x71, 0xcc, 0x0a, 0x72, 0x62, 0xbf, 0xb7, 0xdc, 0x0f,
+	0xe0, 0x18, 0x7d, 0x4c, 0x42, 0x52, 0x11, 0xee, 0x9a, 0x84, 0xc4, 0x28, 0x1d, 0xfc, 0xb2, 0x61,
+	0xfb, 0x13, 0x9a, 0xe0, 0x4a, 0x09, 0xdc, 0xaa, 0x9a, 0x0b, 0x5b, 0x95, 0x4f, 0x1a, 0xd9, 0x9a,
+	0x58, 0x29, 0x8a, 0xce, 0x18, 0x0b, 0x14, 0x8d, 0xf8, 0x75, 0x48, 0x29, 0x74, 0x66, 0x61, 0x5c,
+	0xa0, 0x54, 0x96, 0x8f, 0x6b, 0x9d, 0x2b, 0xd9, 0x46, 0x93, 0x2b, 0x31, 0xc7, 0x8b, 0x82, 0x75,
+	0x75, 0x8e, 0x17, 0x85, 0xce, 0x95, 0x6c, 0xb3, 0xc9, 0x95, 0x74, 0x1f, 0xba, 0xdf, 0xc7, 0xb1,
+	0x90, 0x05, 0xdb, 0xc2, 0x46, 0x3a, 0x52, 0xce, 0x41, 0x55, 0x2e, 0x53, 0x21, 0x53, 0xd6, 0x43,
+	0x9d, 0x8c, 0x0c, 0x3d, 0x86, 0x5e, 0x9e, 0xf2, 0x38, 0xbb, 0x4d, 0xd2, 0x19, 0x73, 0xf0, 0x42,
+	0x59, 0x2d, 0x96, 0xb6, 0xff, 0xe7, 0x7a, 0xdf, 0x7f, 0x80, 0xd2, 0x23, 0x00, 0x3c, 0xca, 0x24,
+	0x2f, 0x23, 0xc9, 0x00, 0x0b, 0x9f, 0xd5, 0x85, 0xb8, 0xe3, 0xe1, 0x96, 0x6f, 0xc0, 0x06, 0xbf,
+	0x09, 0x74, 0xfc, 0x24, 0xc9, 0xe9, 0x04, 0x76, 0xa2, 0xd5, 0x6b, 0xcd, 0x98, 0x8d, 0x4e, 0x39,
+	0x58, 0x71, 0x8a, 0x02, 0x7b, 0x6b, 0x02, 0x68, 0xbb, 0x3c, 0x22, 0xa0, 0xa7, 0x00, 0x41, 0xed,
+	0xac, 0x8c, 0x11, 0xa4, 0x7b, 0xf5, 0x98, 0xae, 0x71, 0x9f, 0x26, 0x32, 0x8a, 0x14, 0xc5, 0x2c,
+	0x14, 0x22, 0x92, 0x4b, 0x9e, 0xaa, 0x67, 0xf4, 0x17, 0x8a, 0xf3, 0x06, 0xa3, 0x29, 0x1e, 0x8a,
+	0x5c, 0x0e, 0x7b, 0xad, 0x03, 0xb7, 0xf8, 0xee, 0x70, 0xd5, 0xc8, 0x2f, 0x56, 0x1a, 0xad, 0x91,
+	0x98, 0x86, 0xfe, 0x02, 0xdb, 0x6b, 0x87, 0x68, 0x21, 0x7f, 0xb7, 0x4a, 0xbe, 0xdf, 0xfe, 0x02,
+	0x4d, 0xda, 0x09, 0x6c, 0xaf, 0x1d, 0xec, 0xdf, 0x1f, 0xdf, 0xd9, 0xde, 0xd7, 0x8e, 0xea, 0xf8,
+	0xc3, 0xfe, 0xef, 0x1c, 0xfb, 0x5f, 0x87, 0x72, 0x79, 0x75, 0x16, 0x74, 0x11, 0x7f, 0xf4, 0x67,
+	0x00, 0x9f, 0x48, 0x47, 0xef, 0xb4, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,107 @@
+package snapshot
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompareGolden compares img to the golden PNG file at path. If update is true, img is written to path instead. If
+// the images differ, img is written next to the golden file with the suffix ".actual.png", along with an image of the
+// differences with the suffix ".diff.png", and an error describing the difference is returned.
+func CompareGolden(path string, img image.Image, update bool) error {
+	if update {
+		return WritePNG(path, img)
+	}
+	golden, err := ReadPNG(path)
+	if err != nil {
+		return fmt.Errorf("snapshot: could not read golden file: %v", err)
+	}
+	n, diff := Diff(golden, img, 0)
+	if n == 0 {
+		return nil
+	}
+
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	if err := WritePNG(base+".actual.png", img); err != nil {
+		return err
+	}
+	if err := WritePNG(base+".diff.png", diff); err != nil {
+		return err
+	}
+	if golden.Bounds().Size() != img.Bounds().Size() {
+		return fmt.Errorf("snapshot: %v is %v, expected %v", path, img.Bounds().Size(), golden.Bounds().Size())
+	}
+	return fmt.Errorf("snapshot: %v pixels differ from %v", n, path)
+}
+
+// Diff returns the number of pixels of a and b where any channel differs by more than tolerance, and an image where
+// those pixels are red, and the rest are a faded copy of a. Pixels outside of either image count as differences.
+func Diff(a, b image.Image, tolerance uint8) (int, *image.RGBA) {
+	ab, bb := a.Bounds(), b.Bounds()
+	w, h := ab.Dx(), ab.Dy()
+	if bb.Dx() > w {
+		w = bb.Dx()
+	}
+	if bb.Dy() > h {
+		h = bb.Dy()
+	}
+	diff := image.NewRGBA(image.Rect(0, 0, w, h))
+	count := 0
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			pa, pb := image.Pt(ab.Min.X+x, ab.Min.Y+y), image.Pt(bb.Min.X+x, bb.Min.Y+y)
+			if !pa.In(ab) || !pb.In(bb) {
+				count += 1
+				diff.SetRGBA(x, y, color.RGBA{0xff, 0, 0, 0xff})
+				continue
+			}
+			ca := color.RGBAModel.Convert(a.At(pa.X, pa.Y)).(color.RGBA)
+			cb := color.RGBAModel.Convert(b.At(pb.X, pb.Y)).(color.RGBA)
+			if differs(ca.R, cb.R, tolerance) || differs(ca.G, cb.G, tolerance) || differs(ca.B, cb.B, tolerance) || differs(ca.A, cb.A, tolerance) {
+				count += 1
+				diff.SetRGBA(x, y, color.RGBA{0xff, 0, 0, 0xff})
+			} else {
+				diff.SetRGBA(x, y, color.RGBA{ca.R / 4, ca.G / 4, ca.B / 4, ca.A / 4})
+			}
+		}
+	}
+	return count, diff
+}
+
+func differs(a, b, tolerance uint8) bool {
+	if a > b {
+		return a-b > tolerance
+	}
+	return b-a > tolerance
+}
+
+// ReadPNG decodes the PNG file at path.
+func ReadPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+// WritePNG encodes img as a PNG file at path, creating its directory if needed.
+func WritePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
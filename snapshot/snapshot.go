@@ -0,0 +1,427 @@
+/*
+Package snapshot renders view hierarchies to images in pure Go, so that screens can be tested for visual regressions
+without a device or simulator.
+
+	var update = flag.Bool("update", false, "update golden files")
+
+	func TestSettings(t *testing.T) {
+		img := snapshot.View(settings.New(), layout.Pt(320, 480), 2)
+		if err := snapshot.CompareGolden("testdata/settings.png", img, *update); err != nil {
+			t.Error(err)
+		}
+	}
+
+Render draws the frames, z-order, transforms and paint styles of each view, along with the content of image views and
+the text of text views. Other native views, such as buttons and switches, are drawn with their paint style only. It
+is an approximation of the native renderers: shadows are a box blur of the view's shape, dashes follow the straight
+edges of a view, and text is drawn with a bundled 7x13 bitmap font that is not scaled by transforms.
+*/
+package snapshot
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/image/font/basicfont"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+	"gomatcha.io/matcha/pb"
+	pbtext "gomatcha.io/matcha/pb/text"
+	pbview "gomatcha.io/matcha/pb/view"
+	"gomatcha.io/matcha/pb/view/imageview"
+	"gomatcha.io/matcha/text"
+	"gomatcha.io/matcha/view"
+)
+
+// View renders v in a new root of the given size, at scale pixels per point.
+func View(v view.View, size layout.Point, scale float64) *image.RGBA {
+	root := view.NewRoot(v)
+	defer root.Stop()
+	root.SetSize(size)
+	return Render(root.Snapshot(), scale)
+}
+
+// Render draws root into a new image the size of its root view, at scale pixels per point. root should contain all
+// build nodes, such as the result of view.Root.Snapshot.
+func Render(root *pbview.Root, scale float64) *image.RGBA {
+	// The root view is the only one that is not a child of another view.
+	children := map[int64]bool{}
+	for _, i := range root.LayoutPaintNodes {
+		for _, j := range i.ChildOrder {
+			children[j] = true
+		}
+	}
+	var top *pbview.LayoutPaintNode
+	for id, i := range root.LayoutPaintNodes {
+		if !children[id] {
+			top = i
+		}
+	}
+	if top == nil {
+		return image.NewRGBA(image.Rectangle{})
+	}
+
+	w := int(math.Ceil((top.Maxx - top.Minx) * scale))
+	h := int(math.Ceil((top.Maxy - top.Miny) * scale))
+	r := &renderer{
+		root:   root,
+		bounds: image.Rect(0, 0, w, h),
+		scale:  scale,
+	}
+	img := image.NewRGBA(r.bounds)
+	r.node(img, top, layout.Transform{}.Scale(scale, scale, 1))
+	return img
+}
+
+type renderer struct {
+	root   *pbview.Root
+	bounds image.Rectangle
+	scale  float64
+}
+
+func (r *renderer) node(dst *image.RGBA, n *pbview.LayoutPaintNode, parent layout.Transform) {
+	frame := layout.Rt(n.Minx, n.Miny, n.Maxx, n.Maxy)
+	s := paint.Style{}
+	if n.PaintStyle != nil {
+		s.UnmarshalProtobuf(n.PaintStyle)
+	}
+	guide := layout.Transform{}
+	if n.Transform != nil {
+		guide.UnmarshalProtobuf(n.Transform)
+	}
+
+	// Map the coordinates of the view into the coordinates of its parent, and then into pixels.
+	ctm := layout.Transform{}.Translate(frame.Min.X, frame.Min.Y, 0)
+	ctm = ctm.Concat(guide.InFrame(frame)).Concat(s.Transform.InFrame(frame)).Concat(parent)
+
+	bounds := layout.Rt(0, 0, frame.Width(), frame.Height())
+	shape := &paint.Path{}
+	shape.AddRoundedRectCorners(bounds, s.Radii())
+	shapeMask := shape.Mask(r.bounds, ctm)
+
+	// Transparent and masked views are drawn into a layer that is composited once it is complete.
+	layer := dst
+	if s.Transparency > 0 || s.Mask != nil {
+		layer = image.NewRGBA(r.bounds)
+	}
+
+	if s.ShadowColor != nil {
+		offset := layout.Transform{}.Translate(s.ShadowOffset.X, s.ShadowOffset.Y, 0)
+		m := shape.Mask(r.bounds, offset.Concat(ctm))
+		blur(m, int(math.Round(s.ShadowRadius*r.scale/2)))
+		fill(layer, s.ShadowColor, m)
+	}
+	if s.BackgroundColor != nil {
+		fill(layer, s.BackgroundColor, shapeMask)
+	}
+	if g := s.BackgroundGradient; g != nil {
+		// Rasterize the gradient in pixels, and then transform it into place.
+		w, h := int(math.Ceil(bounds.Width()*r.scale)), int(math.Ceil(bounds.Height()*r.scale))
+		scaled := *g
+		scaled.Radius *= r.scale
+		r.drawImage(layer, scaled.Rasterize(w, h), bounds, ctm, shapeMask)
+	}
+	if b, ok := r.root.BuildNodes[n.Id]; ok {
+		r.content(layer, b, bounds, ctm, shapeMask)
+	}
+	if s.Drawing != nil {
+		// Custom drawings are clipped to the bounds of the view, like the backing store of a native view.
+		rect := &paint.Path{}
+		rect.AddRect(bounds)
+		tmp := image.NewRGBA(r.bounds)
+		s.Drawing.Draw(tmp, ctm)
+		draw.DrawMask(layer, r.bounds, tmp, r.bounds.Min, rect.Mask(r.bounds, ctm), r.bounds.Min, draw.Over)
+	}
+
+	// Children are drawn in order, which is sorted by z-index.
+	childDst := layer
+	if s.ClipsChildren {
+		childDst = image.NewRGBA(r.bounds)
+	}
+	for _, id := range n.ChildOrder {
+		if child, ok := r.root.LayoutPaintNodes[id]; ok {
+			r.node(childDst, child, ctm)
+		}
+	}
+	if s.ClipsChildren {
+		draw.DrawMask(layer, r.bounds, childDst, r.bounds.Min, shapeMask, r.bounds.Min, draw.Over)
+	}
+
+	// Borders are drawn above children, like CALayer.
+	r.borders(layer, s, bounds, ctm, shapeMask)
+
+	if layer != dst {
+		mask := image.NewAlpha(r.bounds)
+		draw.Draw(mask, r.bounds, image.NewUniform(color.Alpha{uint8(math.Round(255 * clamp(1-s.Transparency)))}), image.Point{}, draw.Src)
+		if s.Mask != nil && s.Mask.Path != nil {
+			multiply(mask, s.Mask.Path.Mask(r.bounds, ctm))
+		}
+		if s.Mask != nil && s.Mask.Image != nil {
+			tmp := image.NewRGBA(r.bounds)
+			r.drawImage(tmp, s.Mask.Image, bounds, ctm, nil)
+			alpha := image.NewAlpha(r.bounds)
+			draw.Draw(alpha, r.bounds, tmp, r.bounds.Min, draw.Src)
+			multiply(mask, alpha)
+		}
+		draw.DrawMask(dst, r.bounds, layer, r.bounds.Min, mask, r.bounds.Min, draw.Over)
+	}
+}
+
+// content draws the native content of image views and text views.
+func (r *renderer) content(dst *image.RGBA, b *pbview.BuildNode, bounds layout.Rect, ctm layout.Transform, mask *image.Alpha) {
+	if b.BridgeValue == nil {
+		return
+	}
+	switch b.BridgeName {
+	case "gomatcha.io/matcha/view/imageview":
+		v := &imageview.View{}
+		if err := ptypes.UnmarshalAny(b.BridgeValue, v); err != nil || v.Image == nil || v.Image.Image == nil {
+			return
+		}
+		var img image.Image = pb.ImageDecode(v.Image.Image)
+		if v.Tint != nil {
+			// Tinted images are templates, where only the alpha channel is used.
+			tinted := image.NewRGBA(img.Bounds())
+			draw.DrawMask(tinted, img.Bounds(), image.NewUniform(pb.ColorDecode(v.Tint)), image.Point{}, img, img.Bounds().Min, draw.Src)
+			img = tinted
+		}
+		scale := v.Scale
+		if scale == 0 {
+			scale = 1
+		}
+		size := layout.Size{Width: float64(img.Bounds().Dx()) / scale, Height: float64(img.Bounds().Dy()) / scale}
+		rect := bounds
+		switch v.ResizeMode {
+		case imageview.ResizeMode_FIT:
+			rect = bounds.AspectFit(size)
+		case imageview.ResizeMode_FILL:
+			rect = bounds.AspectFill(size)
+		case imageview.ResizeMode_CENTER:
+			c := bounds.Center()
+			rect = layout.Rt(c.X-size.Width/2, c.Y-size.Height/2, c.X+size.Width/2, c.Y+size.Height/2)
+		}
+		r.drawImage(dst, img, rect, ctm, mask)
+	case "gomatcha.io/matcha/view/textview":
+		st := &pbtext.StyledText{}
+		if err := ptypes.UnmarshalAny(b.BridgeValue, st); err != nil || st.Text == nil {
+			return
+		}
+		col := color.Color(color.Black)
+		align := pbtext.TextAlignment_TEXT_ALIGNMENT_LEFT
+		if st.Style != nil {
+			if st.Style.TextColor != nil {
+				col = pb.ColorDecode(st.Style.TextColor)
+			}
+			align = st.Style.TextAlignment
+		}
+		c := paint.NewCanvas(bounds.Size().Point())
+		metrics := basicfont.Face7x13
+		for idx, line := range wrap(st.Text.Text, int(bounds.Width())/metrics.Advance) {
+			x := 0.0
+			width := float64(len([]rune(line)) * metrics.Advance)
+			switch align {
+			case pbtext.TextAlignment_TEXT_ALIGNMENT_RIGHT:
+				x = bounds.Width() - width
+			case pbtext.TextAlignment_TEXT_ALIGNMENT_CENTER:
+				x = (bounds.Width() - width) / 2
+			}
+			y := float64(metrics.Ascent + idx*metrics.Height)
+			c.FillText(line, layout.Pt(x, y), text.Font{}, col)
+		}
+		c.Drawing().Draw(dst, ctm)
+	}
+}
+
+// borders draws the border of each edge of s. The border of an edge is the part of the ring between the bounds and
+// the inset bounds that is closest to that edge.
+func (r *renderer) borders(dst *image.RGBA, s paint.Style, bounds layout.Rect, ctm layout.Transform, outer *image.Alpha) {
+	b := s.EdgeBorders()
+	if b.Top.Width <= 0 && b.Left.Width <= 0 && b.Bottom.Width <= 0 && b.Right.Width <= 0 {
+		return
+	}
+	radii := s.Radii().Fit(bounds.Size())
+	innerRect := layout.Rt(bounds.Min.X+b.Left.Width, bounds.Min.Y+b.Top.Width, bounds.Max.X-b.Right.Width, bounds.Max.Y-b.Bottom.Width)
+	inner := &paint.Path{}
+	if innerRect.Max.X > innerRect.Min.X && innerRect.Max.Y > innerRect.Min.Y {
+		inner.AddRoundedRectCorners(innerRect, paint.CornerRadii{
+			TopLeft:     math.Max(radii.TopLeft-math.Max(b.Top.Width, b.Left.Width), 0),
+			TopRight:    math.Max(radii.TopRight-math.Max(b.Top.Width, b.Right.Width), 0),
+			BottomRight: math.Max(radii.BottomRight-math.Max(b.Bottom.Width, b.Right.Width), 0),
+			BottomLeft:  math.Max(radii.BottomLeft-math.Max(b.Bottom.Width, b.Left.Width), 0),
+		})
+	}
+	ring := image.NewAlpha(r.bounds)
+	copy(ring.Pix, outer.Pix)
+	subtract(ring, inner.Mask(r.bounds, ctm))
+
+	w, h := bounds.Width(), bounds.Height()
+	tl, tr := bounds.Min, layout.Pt(bounds.Max.X, bounds.Min.Y)
+	br, bl := bounds.Max, layout.Pt(bounds.Min.X, bounds.Max.Y)
+	itl, itr := innerRect.Min, layout.Pt(innerRect.Max.X, innerRect.Min.Y)
+	ibr, ibl := innerRect.Max, layout.Pt(innerRect.Min.X, innerRect.Max.Y)
+	for _, i := range []struct {
+		border paint.Border
+		region []layout.Point
+		// The dash pattern runs clockwise around the view, from start along dir.
+		start, dir layout.Point
+		length     float64
+	}{
+		{b.Top, []layout.Point{tl, tr, itr, itl}, tl, layout.Pt(1, 0), w},
+		{b.Right, []layout.Point{tr, br, ibr, itr}, tr, layout.Pt(0, 1), h},
+		{b.Bottom, []layout.Point{br, bl, ibl, ibr}, br, layout.Pt(-1, 0), w},
+		{b.Left, []layout.Point{bl, tl, itl, ibl}, bl, layout.Pt(0, -1), h},
+	} {
+		if i.border.Width <= 0 || i.border.Color == nil {
+			continue
+		}
+		region := &paint.Path{}
+		region.MoveTo(i.region[0])
+		for _, j := range i.region[1:] {
+			region.LineTo(j)
+		}
+		region.Close()
+		m := region.Mask(r.bounds, ctm)
+		multiply(m, ring)
+		if dash := dashes(i.border, i.start, i.dir, i.length, math.Max(w, h)); dash != nil {
+			multiply(m, dash.Mask(r.bounds, ctm))
+		}
+		fill(dst, i.border.Color, m)
+	}
+}
+
+// dashes returns a path that covers the dashes of b, along the line from start in the direction dir. The dashes
+// extend depth in both directions perpendicular to the line. It returns nil if b is solid.
+func dashes(b paint.Border, start, dir layout.Point, length, depth float64) *paint.Path {
+	total := 0.0
+	for _, i := range b.Dash {
+		if i < 0 {
+			return nil
+		}
+		total += i
+	}
+	if total <= 0 {
+		return nil
+	}
+	normal := layout.Pt(-dir.Y, dir.X).Mul(depth)
+	p := &paint.Path{}
+	pos := -math.Mod(b.DashPhase, total)
+	if pos > 0 {
+		pos -= total
+	}
+	for idx := 0; pos < length; idx = (idx + 1) % len(b.Dash) {
+		end := pos + b.Dash[idx]
+		if idx%2 == 0 && end > 0 {
+			a, c := start.Add(dir.Mul(math.Max(pos, 0))), start.Add(dir.Mul(math.Min(end, length)))
+			p.MoveTo(a.Add(normal))
+			p.LineTo(c.Add(normal))
+			p.LineTo(c.Sub(normal))
+			p.LineTo(a.Sub(normal))
+			p.Close()
+		}
+		pos = end
+	}
+	return p
+}
+
+// drawImage draws img, stretched to rect in the coordinates of a view, into dst. If mask is not nil, only the
+// area inside of the mask is drawn.
+func (r *renderer) drawImage(dst *image.RGBA, img image.Image, rect layout.Rect, ctm layout.Transform, mask *image.Alpha) {
+	c := paint.NewCanvas(rect.Size().Point())
+	c.DrawImage(img, rect)
+	if mask == nil {
+		c.Drawing().Draw(dst, ctm)
+		return
+	}
+	tmp := image.NewRGBA(r.bounds)
+	c.Drawing().Draw(tmp, ctm)
+	draw.DrawMask(dst, r.bounds, tmp, r.bounds.Min, mask, r.bounds.Min, draw.Over)
+}
+
+// wrap splits str into lines of at most width characters, breaking at spaces where possible.
+func wrap(str string, width int) []string {
+	lines := []string{}
+	for _, para := range strings.Split(str, "\n") {
+		line := []rune{}
+		for _, word := range strings.Split(para, " ") {
+			w := []rune(word)
+			if len(line) > 0 && width > 0 && len(line)+1+len(w) > width {
+				lines = append(lines, string(line))
+				line = nil
+			}
+			if len(line) > 0 {
+				line = append(line, ' ')
+			}
+			line = append(line, w...)
+			for width > 0 && len(line) > width {
+				lines = append(lines, string(line[:width]))
+				line = line[width:]
+			}
+		}
+		lines = append(lines, string(line))
+	}
+	return lines
+}
+
+func fill(dst *image.RGBA, c color.Color, mask *image.Alpha) {
+	draw.DrawMask(dst, dst.Bounds(), image.NewUniform(c), image.Point{}, mask, mask.Bounds().Min, draw.Over)
+}
+
+// multiply multiplies the coverage of a by b. They must have the same bounds.
+func multiply(a, b *image.Alpha) {
+	for idx, i := range b.Pix {
+		a.Pix[idx] = uint8(uint16(a.Pix[idx]) * uint16(i) / 0xff)
+	}
+}
+
+// subtract removes the coverage of b from a. They must have the same bounds.
+func subtract(a, b *image.Alpha) {
+	for idx, i := range b.Pix {
+		a.Pix[idx] = uint8(uint16(a.Pix[idx]) * uint16(0xff-i) / 0xff)
+	}
+}
+
+// blur approximates a gaussian blur of m with three passes of a box blur with the given radius in pixels.
+func blur(m *image.Alpha, radius int) {
+	if radius <= 0 {
+		return
+	}
+	w, h := m.Rect.Dx(), m.Rect.Dy()
+	line := make([]int, 0, w+h)
+	pass := func(get func(i int) *uint8, n int) {
+		line = line[:0]
+		for i := 0; i < n; i++ {
+			line = append(line, int(*get(i)))
+		}
+		sum := 0
+		for i := -radius; i <= radius; i++ {
+			if i >= 0 && i < n {
+				sum += line[i]
+			}
+		}
+		for i := 0; i < n; i++ {
+			*get(i) = uint8(sum / (2*radius + 1))
+			if j := i - radius; j >= 0 {
+				sum -= line[j]
+			}
+			if j := i + radius + 1; j < n {
+				sum += line[j]
+			}
+		}
+	}
+	for k := 0; k < 3; k++ {
+		for y := 0; y < h; y++ {
+			pass(func(i int) *uint8 { return &m.Pix[y*m.Stride+i] }, w)
+		}
+		for x := 0; x < w; x++ {
+			pass(func(i int) *uint8 { return &m.Pix[i*m.Stride+x] }, h)
+		}
+	}
+}
+
+func clamp(v float64) float64 {
+	return math.Min(math.Max(v, 0), 1)
+}
@@ -0,0 +1,156 @@
+package snapshot
+
+import (
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/layout/absolute"
+	"gomatcha.io/matcha/paint"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/basicview"
+	"gomatcha.io/matcha/view/imageview"
+)
+
+var (
+	white = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	red   = color.RGBA{0xff, 0, 0, 0xff}
+	green = color.RGBA{0, 0xff, 0, 0xff}
+	blue  = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func container(size float64, style *paint.Style, children map[layout.Rect]view.View) *basicview.View {
+	l := &absolute.Layouter{Guide: layout.Guide{Frame: layout.Rt(0, 0, size, size)}}
+	for frame, child := range children {
+		l.Add(child, layout.Guide{Frame: frame})
+	}
+	v := basicview.New()
+	v.Painter = style
+	v.Layouter = l
+	v.Children = l.Views()
+	return v
+}
+
+func styled(s *paint.Style) *basicview.View {
+	v := basicview.New()
+	v.Painter = s
+	return v
+}
+
+type pixelCase struct {
+	x, y  int
+	color color.RGBA
+}
+
+func checkPixels(t *testing.T, img *image.RGBA, cases []pixelCase) {
+	for _, i := range cases {
+		if c := img.RGBAAt(i.x, i.y); c != i.color {
+			t.Errorf("Pixel (%v, %v) = %v, expected %v", i.x, i.y, c, i.color)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	l := &absolute.Layouter{Guide: layout.Guide{Frame: layout.Rt(0, 0, 40, 40)}}
+	l.Add(styled(&paint.Style{BackgroundColor: red}), layout.Guide{Frame: layout.Rt(0, 0, 20, 20), ZIndex: 1})
+	l.Add(styled(&paint.Style{BackgroundColor: blue}), layout.Guide{Frame: layout.Rt(10, 10, 30, 30)})
+	l.Add(styled(&paint.Style{BackgroundColor: green}), layout.Guide{Frame: layout.Rt(30, 30, 50, 50)})
+	l.Add(styled(&paint.Style{BackgroundColor: color.Black, Transparency: 0.5}), layout.Guide{Frame: layout.Rt(0, 30, 10, 40)})
+	root := basicview.New()
+	root.Painter = &paint.Style{BackgroundColor: white, CornerRadius: 10, ClipsChildren: true}
+	root.Layouter = l
+	root.Children = l.Views()
+
+	img := View(root, layout.Pt(40, 40), 1)
+	if img.Bounds() != image.Rect(0, 0, 40, 40) {
+		t.Fatalf("Incorrect bounds: %v", img.Bounds())
+	}
+	checkPixels(t, img, []pixelCase{
+		// Children with a higher z-index are drawn in front.
+		{15, 15, red},
+		{25, 25, blue},
+		{35, 20, white},
+		{35, 35, green},
+		// Children are clipped to the rounded corners of the root.
+		{39, 39, color.RGBA{}},
+		{5, 35, color.RGBA{0x7f, 0x7f, 0x7f, 0xff}},
+	})
+
+	// The image is scaled along with its contents.
+	img = View(root, layout.Pt(40, 40), 2)
+	if img.Bounds() != image.Rect(0, 0, 80, 80) {
+		t.Fatalf("Incorrect bounds: %v", img.Bounds())
+	}
+	checkPixels(t, img, []pixelCase{{30, 30, red}, {50, 50, blue}})
+}
+
+func TestRenderBorders(t *testing.T) {
+	v := styled(&paint.Style{
+		BackgroundColor: white,
+		Borders: &paint.Borders{
+			Top:  paint.Border{Color: red, Width: 2, Dash: []float64{4, 4}},
+			Left: paint.Border{Color: blue, Width: 2},
+		},
+	})
+	img := View(container(20, nil, map[layout.Rect]view.View{layout.Rt(0, 0, 20, 20): v}), layout.Pt(20, 20), 1)
+	checkPixels(t, img, []pixelCase{
+		// Dashes alternate every 4 points along the top edge.
+		{3, 0, red},
+		{5, 1, white},
+		{9, 1, red},
+		{0, 10, blue},
+		{10, 10, white},
+	})
+}
+
+func TestRenderTransform(t *testing.T) {
+	v := styled(&paint.Style{BackgroundColor: red, Transform: layout.Transform{}.Scale(0.5, 0.5, 1)})
+	img := View(container(20, nil, map[layout.Rect]view.View{layout.Rt(0, 0, 20, 20): v}), layout.Pt(20, 20), 1)
+	checkPixels(t, img, []pixelCase{{10, 10, red}, {2, 2, color.RGBA{}}})
+}
+
+func TestRenderImage(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.SetRGBA(0, 0, blue)
+	src.SetRGBA(1, 0, blue)
+	iv := imageview.New()
+	iv.Image = src
+	iv.ResizeMode = imageview.ResizeModeFit
+	img := View(container(20, nil, map[layout.Rect]view.View{layout.Rt(0, 0, 20, 20): iv}), layout.Pt(20, 20), 1)
+	checkPixels(t, img, []pixelCase{{10, 10, blue}, {10, 2, color.RGBA{}}})
+}
+
+func TestGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "golden", "view.png")
+
+	img := View(styled(&paint.Style{BackgroundColor: red}), layout.Pt(4, 4), 1)
+	if err := CompareGolden(path, img, false); err == nil {
+		t.Error("Expected an error for a missing golden file")
+	}
+	if err := CompareGolden(path, img, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := CompareGolden(path, img, false); err != nil {
+		t.Error(err)
+	}
+
+	img.SetRGBA(1, 1, blue)
+	if err := CompareGolden(path, img, false); err == nil {
+		t.Error("Expected an error for a changed image")
+	}
+	if _, err := ReadPNG(filepath.Join(dir, "golden", "view.actual.png")); err != nil {
+		t.Errorf("Expected the actual image to be written: %v", err)
+	}
+	if n, _ := Diff(img, image.NewRGBA(image.Rect(0, 0, 4, 5)), 0); n != 20 {
+		t.Errorf("Incorrect diff: %v", n)
+	}
+}
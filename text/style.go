@@ -3,6 +3,7 @@ package text
 import (
 	"image/color"
 
+	"gomatcha.io/matcha/layout"
 	"gomatcha.io/matcha/pb"
 	pbtext "gomatcha.io/matcha/pb/text"
 )
@@ -191,7 +192,7 @@ func (f *Style) copy() *Style {
 		c.attributes[k] = v
 	}
 	for k, v := range f.cleared {
-		c.attributes[k] = v
+		c.cleared[k] = v
 	}
 	return c
 }
@@ -227,6 +228,28 @@ func (f *Style) MarshalProtobuf() *pbtext.TextStyle {
 	}
 }
 
+// DefaultAlignment returns the alignment of text that does not set one, in the layout direction d.
+func DefaultAlignment(d layout.Direction) Alignment {
+	if d == layout.RightToLeft {
+		return AlignmentRight
+	}
+	return AlignmentLeft
+}
+
+// ForDirection returns f with its alignment defaulting to DefaultAlignment(d) instead of AlignmentLeft. f is returned
+// unchanged if it sets an alignment.
+func (f *Style) ForDirection(d layout.Direction) *Style {
+	if f == nil {
+		f = &Style{}
+	}
+	if _, ok := f.attributes[styleKeyAlignment]; ok || d != layout.RightToLeft {
+		return f
+	}
+	c := f.copy()
+	c.set(styleKeyAlignment, DefaultAlignment(d))
+	return c
+}
+
 func (f *Style) Alignment() Alignment {
 	return f.get(styleKeyAlignment).(Alignment)
 }
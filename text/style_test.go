@@ -0,0 +1,32 @@
+package text
+
+import (
+	"image/color"
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+)
+
+func TestForDirection(t *testing.T) {
+	s := &Style{}
+	s.SetTextColor(color.White)
+	if a := s.ForDirection(layout.LeftToRight).Alignment(); a != AlignmentLeft {
+		t.Errorf("Incorrect alignment: %v", a)
+	}
+	rtl := s.ForDirection(layout.RightToLeft)
+	if a := rtl.Alignment(); a != AlignmentRight {
+		t.Errorf("Incorrect alignment: %v", a)
+	}
+	if s.Alignment() != AlignmentLeft || rtl.TextColor() != color.White {
+		t.Errorf("Style was not copied correctly")
+	}
+
+	// An explicit alignment is not changed.
+	s.SetAlignment(AlignmentCenter)
+	if a := s.ForDirection(layout.RightToLeft).Alignment(); a != AlignmentCenter {
+		t.Errorf("Incorrect alignment: %v", a)
+	}
+	if a := (*Style)(nil).ForDirection(layout.RightToLeft).Alignment(); a != AlignmentRight {
+		t.Errorf("Incorrect alignment: %v", a)
+	}
+}
@@ -369,6 +369,130 @@ func (r *ButtonRecognizer) marshalProtobuf(ctx *view.Context) (proto.Message, ma
 		}
 }
 
+// PanAxis restricts the direction that a PanRecognizer tracks.
+type PanAxis int
+
+const (
+	// PanAxisAny tracks movement in any direction.
+	PanAxisAny PanAxis = iota
+	// PanAxisHorizontal only recognizes mostly horizontal movement, and ignores vertical movement.
+	PanAxisHorizontal
+	// PanAxisVertical only recognizes mostly vertical movement, and ignores horizontal movement.
+	PanAxisVertical
+)
+
+// PanEvent is emitted by PanRecognizer, representing its current state.
+type PanEvent struct {
+	Kind      EventKind
+	Timestamp time.Time
+	// Position is the location of the touches in the view's coordinates.
+	Position layout.Point
+	// Translation is the distance the touches have moved since the pan started.
+	Translation layout.Point
+	// Velocity is the speed of the touches in points per second.
+	Velocity layout.Point
+	// Touches is the number of fingers touching the view.
+	Touches int
+}
+
+func (e *PanEvent) unmarshalProtobuf(ev *pbtouch.PanEvent) error {
+	t, err := ptypes.Timestamp(ev.Timestamp)
+	if err != nil {
+		return err
+	}
+	e.Kind = EventKind(ev.Kind)
+	e.Timestamp = t
+	e.Position.UnmarshalProtobuf(ev.Position)
+	e.Translation.UnmarshalProtobuf(ev.Translation)
+	e.Velocity.UnmarshalProtobuf(ev.Velocity)
+	e.Touches = int(ev.Touches)
+	return nil
+}
+
+// lock removes the movement of e that is not along axis.
+func (e *PanEvent) lock(axis PanAxis) {
+	switch axis {
+	case PanAxisHorizontal:
+		e.Translation.Y = 0
+		e.Velocity.Y = 0
+	case PanAxisVertical:
+		e.Translation.X = 0
+		e.Velocity.X = 0
+	}
+}
+
+// PanRecognizer is a continuous recognizer that tracks touches dragging across the view. OnTouch is called with
+// EventKindPossible when the touches start moving, EventKindChanged as they move, and EventKindRecognized when they are
+// lifted. It is called with EventKindFailed if the pan is cancelled.
+//
+// The iOS client does not have a native pan recognizer yet, so OnTouch is not called on device. Native support is
+// tracked in TODO.md.
+//
+//	pan := &touch.PanRecognizer{
+//		Axis: touch.PanAxisHorizontal,
+//		OnTouch: func(e *touch.PanEvent) {
+//			switch e.Kind {
+//			case touch.EventKindChanged:
+//				v.offset.SetValue(v.start + e.Translation.X)
+//			case touch.EventKindRecognized:
+//				v.offset.Run(&animate.Decay{Start: v.offset.Value(), Velocity: e.Velocity.X})
+//			}
+//		},
+//	}
+type PanRecognizer struct {
+	// MinTouches is the minimum number of fingers that must touch the view. If it is 0, 1 is used.
+	MinTouches int
+	// MaxTouches is the maximum number of fingers that may touch the view. If it is 0, there is no maximum.
+	MaxTouches int
+	// Axis locks the pan to horizontal or vertical movement.
+	Axis    PanAxis
+	OnTouch func(e *PanEvent)
+}
+
+func (r *PanRecognizer) equal(a Recognizer) bool {
+	b, ok := a.(*PanRecognizer)
+	if !ok {
+		return false
+	}
+	return r.MinTouches == b.MinTouches && r.MaxTouches == b.MaxTouches && r.Axis == b.Axis
+}
+
+func (r *PanRecognizer) marshalProtobuf(ctx *view.Context) (proto.Message, map[string]interface{}) {
+	funcId := newFuncId()
+	f := func(data []byte) {
+		event := &PanEvent{}
+		pbevent := &pbtouch.PanEvent{}
+		err := proto.Unmarshal(data, pbevent)
+		if err != nil {
+			fmt.Println("error", err)
+			return
+		}
+
+		if err := event.unmarshalProtobuf(pbevent); err != nil {
+			fmt.Println("error", err)
+			return
+		}
+		event.lock(r.Axis)
+
+		if r.OnTouch != nil {
+			r.OnTouch(event)
+		}
+	}
+
+	minTouches := r.MinTouches
+	if minTouches < 1 {
+		minTouches = 1
+	}
+	return &pbtouch.PanRecognizer{
+			FuncId:     funcId,
+			MinTouches: int64(minTouches),
+			MaxTouches: int64(r.MaxTouches),
+			Axis:       pbtouch.PanAxis(r.Axis),
+		}, map[string]interface{}{
+			strconv.Itoa(int(funcId)): f,
+		}
+}
+
 func idSliceToIntSlice(ids []view.Id) []int64 {
 	ints := make([]int64, len(ids))
 	for idx, i := range ids {
@@ -0,0 +1,70 @@
+package touch
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"gomatcha.io/matcha/layout"
+	pblayout "gomatcha.io/matcha/pb/layout"
+	pbtouch "gomatcha.io/matcha/pb/touch"
+)
+
+func TestPanRecognizer(t *testing.T) {
+	var event *PanEvent
+	r := &PanRecognizer{
+		MaxTouches: 2,
+		Axis:       PanAxisHorizontal,
+		OnTouch: func(e *PanEvent) {
+			event = e
+		},
+	}
+	msg, funcs := r.marshalProtobuf(nil)
+	pbr, ok := msg.(*pbtouch.PanRecognizer)
+	if !ok {
+		t.Fatalf("Incorrect message: %v", msg)
+	}
+	if pbr.MinTouches != 1 || pbr.MaxTouches != 2 || pbr.Axis != pbtouch.PanAxis_PAN_AXIS_HORIZONTAL {
+		t.Errorf("Incorrect recognizer: %v", pbr)
+	}
+	f, ok := funcs[strconv.Itoa(int(pbr.FuncId))].(func([]byte))
+	if !ok {
+		t.Fatalf("Missing func: %v", funcs)
+	}
+
+	now := time.Unix(100, 0)
+	ts, _ := ptypes.TimestampProto(now)
+	data, err := proto.Marshal(&pbtouch.PanEvent{
+		Timestamp:   ts,
+		Kind:        pbtouch.EventKind_EVENT_KIND_CHANGED,
+		Position:    &pblayout.Point{X: 10, Y: 20},
+		Translation: &pblayout.Point{X: 5, Y: 3},
+		Velocity:    &pblayout.Point{X: 100, Y: -50},
+		Touches:     2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f(data)
+	expected := PanEvent{
+		Kind:        EventKindChanged,
+		Timestamp:   now,
+		Position:    layout.Pt(10, 20),
+		Translation: layout.Pt(5, 0),
+		Velocity:    layout.Pt(100, 0),
+		Touches:     2,
+	}
+	if event == nil || !event.Timestamp.Equal(now) {
+		t.Fatalf("Incorrect event: %v", event)
+	}
+	event.Timestamp = now
+	if *event != expected {
+		t.Errorf("Incorrect event: %+v, expected %+v", event, expected)
+	}
+
+	if !r.equal(&PanRecognizer{MaxTouches: 2, Axis: PanAxisHorizontal}) || r.equal(&PanRecognizer{Axis: PanAxisVertical}) || r.equal(&TapRecognizer{}) {
+		t.Error("Incorrect equal")
+	}
+}
@@ -0,0 +1,283 @@
+package view
+
+import (
+	"image/color"
+	"reflect"
+	"sync"
+	"time"
+
+	"gomatcha.io/matcha/animate"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+)
+
+// DefaultAnimationDuration is the duration used by Animate when dur is 0.
+const DefaultAnimationDuration = 250 * time.Millisecond
+
+type transaction struct {
+	duration time.Duration
+	ease     animate.FloatInterpolater
+}
+
+var transactions = struct {
+	mu      sync.Mutex
+	current *transaction
+}{}
+
+func currentTransaction() *transaction {
+	transactions.mu.Lock()
+	defer transactions.mu.Unlock()
+
+	return transactions.current
+}
+
+// Animate calls f inside of an animation transaction. If any view is signaled during f, every frame and paint style
+// that changes in the next update of its hierarchy is animated from its previous value to its new value over dur,
+// using ease. If dur is 0, DefaultAnimationDuration is used, and if ease is nil, animate.DefaultEase is used. Views
+// that are added or removed are not animated.
+//
+//	child.OnClick = func() {
+//		view.Animate(300*time.Millisecond, animate.DefaultInOutEase, func() {
+//			v.expanded = !v.expanded
+//			v.Signal()
+//		})
+//	}
+func Animate(dur time.Duration, ease animate.FloatInterpolater, f func()) {
+	if dur == 0 {
+		dur = DefaultAnimationDuration
+	}
+	if ease == nil {
+		ease = animate.DefaultEase
+	}
+
+	transactions.mu.Lock()
+	prev := transactions.current
+	transactions.current = &transaction{duration: dur, ease: ease}
+	transactions.mu.Unlock()
+
+	defer func() {
+		transactions.mu.Lock()
+		transactions.current = prev
+		transactions.mu.Unlock()
+	}()
+	f()
+}
+
+// implicitAnimation interpolates the frame and paint style of a node between two updates.
+type implicitAnimation struct {
+	start     time.Time
+	duration  time.Duration
+	ease      animate.FloatInterpolater
+	fromGuide layout.Guide
+	fromStyle paint.Style
+}
+
+// ratio returns the eased progress of a at time t, and false once a has finished.
+func (a *implicitAnimation) ratio(t time.Time) (float64, bool) {
+	d := t.Sub(a.start)
+	if d >= a.duration {
+		return 1, false
+	}
+	if d < 0 {
+		d = 0
+	}
+	return a.ease.Interpolate(float64(d) / float64(a.duration)), true
+}
+
+// displayGuide returns the guide that n is displayed with at time t.
+func (n *node) displayGuide(t time.Time) layout.Guide {
+	g := layout.Guide{}
+	if n.layoutGuide != nil {
+		g = *n.layoutGuide
+	}
+	if n.animation == nil {
+		return g
+	}
+	r, _ := n.animation.ratio(t)
+	return lerpGuide(n.animation.fromGuide, g, r)
+}
+
+// displayStyle returns the paint style that n is displayed with at time t.
+func (n *node) displayStyle(t time.Time) paint.Style {
+	if n.animation == nil {
+		return n.paintOptions
+	}
+	r, _ := n.animation.ratio(t)
+	return lerpStyle(n.animation.fromStyle, n.paintOptions, r)
+}
+
+// animate compares the frames and paint styles of all nodes to the previous update, and starts or stops their
+// implicit animations. It returns true if any animations are running at time t.
+func (root *root) animate(t time.Time) bool {
+	running := false
+	for _, n := range root.nodes {
+		guide := layout.Guide{}
+		if n.layoutGuide != nil {
+			guide = *n.layoutGuide
+		}
+		if n.displayed && (guide != n.displayedGuide || !reflect.DeepEqual(n.paintOptions, n.displayedStyle)) {
+			if root.transaction != nil {
+				// Start from the values that are currently on screen, which may be mid-animation.
+				n.animation = &implicitAnimation{
+					start:     t,
+					duration:  root.transaction.duration,
+					ease:      root.transaction.ease,
+					fromGuide: lerpGuideAt(n, n.displayedGuide, t),
+					fromStyle: lerpStyleAt(n, n.displayedStyle, t),
+				}
+			} else {
+				n.animation = nil
+			}
+		}
+		n.displayed = true
+		n.displayedGuide = guide
+		n.displayedStyle = n.paintOptions
+
+		if n.animation != nil {
+			if _, ok := n.animation.ratio(t); ok {
+				running = true
+			} else {
+				n.animation = nil
+			}
+			n.layoutId += 1
+			n.paintId += 1
+		}
+	}
+	return running
+}
+
+// lerpGuideAt returns the guide that n displayed at time t, before its target changed from prev.
+func lerpGuideAt(n *node, prev layout.Guide, t time.Time) layout.Guide {
+	if n.animation == nil {
+		return prev
+	}
+	r, _ := n.animation.ratio(t)
+	return lerpGuide(n.animation.fromGuide, prev, r)
+}
+
+// lerpStyleAt returns the paint style that n displayed at time t, before its target changed from prev.
+func lerpStyleAt(n *node, prev paint.Style, t time.Time) paint.Style {
+	if n.animation == nil {
+		return prev
+	}
+	r, _ := n.animation.ratio(t)
+	return lerpStyle(n.animation.fromStyle, prev, r)
+}
+
+func lerp(a, b, r float64) float64 {
+	return a + (b-a)*r
+}
+
+func lerpPoint(a, b layout.Point, r float64) layout.Point {
+	return layout.Pt(lerp(a.X, b.X, r), lerp(a.Y, b.Y, r))
+}
+
+func lerpTransform(a, b layout.Transform, r float64) layout.Transform {
+	if a == b {
+		return b
+	}
+	ma, mb := a.Matrix(), b.Matrix()
+	m := [4][4]float64{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			m[i][j] = lerp(ma[i][j], mb[i][j], r)
+		}
+	}
+	return layout.NewTransform(m).WithAnchor(lerpPoint(a.Anchor(), b.Anchor(), r))
+}
+
+func lerpGuide(a, b layout.Guide, r float64) layout.Guide {
+	return layout.Guide{
+		Frame:     layout.Rect{Min: lerpPoint(a.Frame.Min, b.Frame.Min, r), Max: lerpPoint(a.Frame.Max, b.Frame.Max, r)},
+		ZIndex:    b.ZIndex,
+		Transform: lerpTransform(a.Transform, b.Transform, r),
+	}
+}
+
+// lerpColor interpolates between premultiplied colors. A nil color is transparent.
+func lerpColor(a, b color.Color, r float64) color.Color {
+	if a == nil && b == nil {
+		return nil
+	}
+	if a == nil {
+		a = color.Transparent
+	}
+	if b == nil {
+		b = color.Transparent
+	}
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	c := func(x, y uint32) uint16 {
+		return uint16(lerp(float64(x), float64(y), r) + 0.5)
+	}
+	return color.RGBA64{R: c(r1, r2), G: c(g1, g2), B: c(b1, b2), A: c(a1, a2)}
+}
+
+// lerpGradient interpolates gradients with the same kind and number of stops. Otherwise b is returned.
+func lerpGradient(a, b *paint.Gradient, r float64) *paint.Gradient {
+	if a == nil || b == nil || a.Kind != b.Kind || len(a.Stops) != len(b.Stops) {
+		return b
+	}
+	g := *b
+	g.Stops = make([]paint.GradientStop, len(b.Stops))
+	for idx := range b.Stops {
+		g.Stops[idx] = paint.GradientStop{
+			Location: lerp(a.Stops[idx].Location, b.Stops[idx].Location, r),
+			Color:    lerpColor(a.Stops[idx].Color, b.Stops[idx].Color, r),
+		}
+	}
+	g.Angle = lerp(a.Angle, b.Angle, r)
+	g.Center = lerpPoint(a.Center, b.Center, r)
+	g.Radius = lerp(a.Radius, b.Radius, r)
+	return &g
+}
+
+func lerpCornerRadii(a, b paint.CornerRadii, r float64) paint.CornerRadii {
+	return paint.CornerRadii{
+		TopLeft:     lerp(a.TopLeft, b.TopLeft, r),
+		TopRight:    lerp(a.TopRight, b.TopRight, r),
+		BottomRight: lerp(a.BottomRight, b.BottomRight, r),
+		BottomLeft:  lerp(a.BottomLeft, b.BottomLeft, r),
+	}
+}
+
+func lerpBorder(a, b paint.Border, r float64) paint.Border {
+	border := b
+	border.Color = lerpColor(a.Color, b.Color, r)
+	border.Width = lerp(a.Width, b.Width, r)
+	border.DashPhase = lerp(a.DashPhase, b.DashPhase, r)
+	return border
+}
+
+// lerpBorders interpolates the borders of each edge. If either is nil, b is returned.
+func lerpBorders(a, b *paint.Borders, r float64) *paint.Borders {
+	if a == nil || b == nil {
+		return b
+	}
+	return &paint.Borders{
+		Top:    lerpBorder(a.Top, b.Top, r),
+		Left:   lerpBorder(a.Left, b.Left, r),
+		Bottom: lerpBorder(a.Bottom, b.Bottom, r),
+		Right:  lerpBorder(a.Right, b.Right, r),
+	}
+}
+
+// lerpStyle interpolates the animatable properties of a and b. Other properties are taken from b.
+func lerpStyle(a, b paint.Style, r float64) paint.Style {
+	s := b
+	s.Transparency = lerp(a.Transparency, b.Transparency, r)
+	s.BackgroundColor = lerpColor(a.BackgroundColor, b.BackgroundColor, r)
+	s.BackgroundGradient = lerpGradient(a.BackgroundGradient, b.BackgroundGradient, r)
+	s.BorderColor = lerpColor(a.BorderColor, b.BorderColor, r)
+	s.BorderWidth = lerp(a.BorderWidth, b.BorderWidth, r)
+	s.Borders = lerpBorders(a.Borders, b.Borders, r)
+	s.CornerRadius = lerp(a.CornerRadius, b.CornerRadius, r)
+	if !a.CornerRadii.IsZero() || !b.CornerRadii.IsZero() {
+		s.CornerRadii = lerpCornerRadii(a.Radii(), b.Radii(), r)
+	}
+	s.ShadowRadius = lerp(a.ShadowRadius, b.ShadowRadius, r)
+	s.ShadowOffset = lerpPoint(a.ShadowOffset, b.ShadowOffset, r)
+	s.ShadowColor = lerpColor(a.ShadowColor, b.ShadowColor, r)
+	s.Transform = lerpTransform(a.Transform, b.Transform, r)
+	return s
+}
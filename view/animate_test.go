@@ -0,0 +1,84 @@
+package view
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"golang.org/x/image/colornames"
+	"gomatcha.io/matcha/animate"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+)
+
+type styleView struct {
+	Embed
+	style *paint.Style
+}
+
+func (v *styleView) Build(ctx *Context) Model {
+	return Model{Painter: v.style}
+}
+
+func TestAnimate(t *testing.T) {
+	l := &guideLayouter{guides: []layout.Guide{{Frame: layout.Rt(0, 0, 10, 10)}}}
+	child := &styleView{style: &paint.Style{BackgroundColor: colornames.Black}}
+	r := newRoot(&guideView{layouter: l, children: []View{child}})
+	r.update(layout.Pt(100, 100))
+	n := r.node.children[0]
+	if n.animation != nil {
+		t.Fatal("New views should not animate")
+	}
+
+	Animate(time.Second, animate.LinearEase{}, func() {
+		l.guides = []layout.Guide{{Frame: layout.Rt(100, 0, 110, 10)}}
+		l.Signal()
+	})
+	child.style.BackgroundColor = colornames.White
+	child.Signal()
+	r.update(layout.Pt(100, 100))
+	if n.animation == nil || !r.animating {
+		t.Fatal("Expected an animation")
+	}
+
+	start := n.animation.start
+	if g := n.displayGuide(start.Add(time.Second / 2)); g.Frame != layout.Rt(50, 0, 60, 10) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	if s := n.displayStyle(start.Add(time.Second / 4)); s.BackgroundColor != (color.RGBA64{0x4000, 0x4000, 0x4000, 0xffff}) {
+		t.Errorf("Incorrect color: %v", s.BackgroundColor)
+	}
+	if g := n.displayGuide(start.Add(2 * time.Second)); g.Frame != layout.Rt(100, 0, 110, 10) {
+		t.Errorf("Incorrect final frame: %v", g.Frame)
+	}
+
+	// Changes outside of a transaction are not animated.
+	l.guides = []layout.Guide{{Frame: layout.Rt(0, 0, 10, 10)}}
+	l.Signal()
+	r.update(layout.Pt(100, 100))
+	if n.animation != nil {
+		t.Error("Unexpected animation")
+	}
+}
+
+func TestLerpStyle(t *testing.T) {
+	a := paint.Style{
+		CornerRadius: 10,
+		Borders:      paint.UniformBorders(paint.Border{Width: 2, DashPhase: 0}),
+	}
+	b := paint.Style{
+		CornerRadii:   paint.Radii(paint.CornerTop, 20),
+		Borders:       paint.UniformBorders(paint.Border{Width: 4, Dash: []float64{2, 2}, DashPhase: 4}),
+		ClipsChildren: true,
+	}
+	s := lerpStyle(a, b, 0.5)
+	if s.CornerRadii != (paint.CornerRadii{TopLeft: 15, TopRight: 15, BottomRight: 5, BottomLeft: 5}) {
+		t.Errorf("Incorrect corner radii: %v", s.CornerRadii)
+	}
+	if s.Borders.Top.Width != 3 || s.Borders.Top.DashPhase != 2 || len(s.Borders.Top.Dash) != 2 {
+		t.Errorf("Incorrect borders: %+v", s.Borders)
+	}
+	if !s.ClipsChildren {
+		t.Error("Expected ClipsChildren from the end style")
+	}
+}
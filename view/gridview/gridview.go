@@ -0,0 +1,103 @@
+/*
+Package gridview implements a scrollable grid of views.
+
+ func (v *GalleryView) Build(ctx *view.Context) view.Model {
+ 	children := []view.View{}
+ 	for _, i := range v.photos {
+ 		child := imageview.New()
+ 		child.Image = i
+ 		child.ResizeMode = imageview.ResizeModeFill
+ 		children = append(children, child)
+ 	}
+
+ 	gallery := gridview.New()
+ 	gallery.Columns = []grid.Track{grid.Fraction(1), grid.Fraction(1), grid.Fraction(1)}
+ 	gallery.AutoRows = grid.Fixed(120)
+ 	gallery.ColumnGap = 1
+ 	gallery.RowGap = 1
+ 	gallery.Children = children
+ 	return view.Model{
+ 		Children: []view.View{gallery},
+ 	}
+ }
+*/
+package gridview
+
+import (
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/layout/grid"
+	"gomatcha.io/matcha/paint"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/scrollview"
+)
+
+// View lays out its children in a grid within a scroll view.
+type View struct {
+	view.Embed
+	Columns   []grid.Track
+	Rows      []grid.Track
+	AutoRows  grid.Track
+	RowGap    float64
+	ColumnGap float64
+	Padding   layout.Insets
+	// Cells optionally positions each child. If Cells is shorter than Children, the remaining children
+	// are placed automatically. Unlike cells passed to grid.Layouter.Add, Row and Column do not default to
+	// grid.Auto, so a zero Row or Column places the child in row or column 0. Set Row or Column to grid.Auto to
+	// only fix one axis, or both to place the child automatically with a span.
+	Cells    []grid.Cell
+	Children []view.View
+
+	Direction                scrollview.Direction
+	ScrollIndicatorDirection scrollview.Direction
+	ScrollEnabled            bool
+	ScrollPosition           *scrollview.ScrollPosition
+	OnScroll                 func(position layout.Point)
+	ContentPainter           paint.Painter
+	PaintStyle               *paint.Style
+}
+
+// New returns either the previous View in ctx with matching key, or a new View if none exists.
+func New() *View {
+	return &View{
+		Direction:                scrollview.Vertical,
+		ScrollIndicatorDirection: scrollview.Vertical,
+		ScrollEnabled:            true,
+	}
+}
+
+// Build implements view.View.
+func (v *View) Build(ctx *view.Context) view.Model {
+	l := &grid.Layouter{
+		Columns:   v.Columns,
+		Rows:      v.Rows,
+		AutoRows:  v.AutoRows,
+		RowGap:    v.RowGap,
+		ColumnGap: v.ColumnGap,
+		Padding:   v.Padding,
+	}
+	for idx, i := range v.Children {
+		if idx < len(v.Cells) {
+			cell := v.Cells[idx]
+			l.Add(i, func(c *grid.Cell) {
+				*c = cell
+			})
+		} else {
+			l.Add(i, nil)
+		}
+	}
+
+	child := scrollview.New()
+	child.Direction = v.Direction
+	child.ScrollIndicatorDirection = v.ScrollIndicatorDirection
+	child.ScrollEnabled = v.ScrollEnabled
+	child.ScrollPosition = v.ScrollPosition
+	child.OnScroll = v.OnScroll
+	child.PaintStyle = v.PaintStyle
+	child.ContentPainter = v.ContentPainter
+	child.ContentLayouter = l
+	child.ContentChildren = l.Views()
+
+	return view.Model{
+		Children: []view.View{child},
+	}
+}
@@ -0,0 +1,51 @@
+package gridview
+
+import (
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/layout/grid"
+	"gomatcha.io/matcha/view/basicview"
+	"gomatcha.io/matcha/view/scrollview"
+)
+
+func TestLayout(t *testing.T) {
+	v := New()
+	v.Columns = []grid.Track{grid.Fixed(50), grid.Fixed(50), grid.Fixed(50)}
+	v.AutoRows = grid.Fixed(20)
+	v.Cells = []grid.Cell{
+		{Row: grid.Auto, Column: grid.Auto, ColumnSpan: 2},
+		{Row: 1, Column: 2},
+	}
+	for i := 0; i < 4; i++ {
+		v.Children = append(v.Children, basicview.New())
+	}
+
+	m := v.Build(nil)
+	content := m.Children[0].(*scrollview.View)
+	if len(content.ContentChildren) != 4 {
+		t.Fatalf("Incorrect children: %v", content.ContentChildren)
+	}
+	ctx := &layout.Context{
+		MaxSize:    layout.Pt(150, 1000),
+		ChildCount: 4,
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			return layout.Guide{Frame: layout.Rt(0, 0, min.X, min.Y)}
+		},
+	}
+	g, gs := content.ContentLayouter.Layout(ctx)
+	if g.Frame != layout.Rt(0, 0, 150, 40) {
+		t.Errorf("Incorrect frame: %v", g.Frame)
+	}
+	frames := []layout.Rect{
+		layout.Rt(0, 0, 100, 20),    // Auto, spanning two columns.
+		layout.Rt(100, 20, 150, 40), // Explicit.
+		layout.Rt(100, 0, 150, 20),  // Auto, next to the first.
+		layout.Rt(0, 20, 50, 40),    // Auto, before the explicit cell.
+	}
+	for idx, i := range gs {
+		if i.Frame != frames[idx] {
+			t.Errorf("Incorrect frame for %v: %v, expected %v", idx, i.Frame, frames[idx])
+		}
+	}
+}
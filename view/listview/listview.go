@@ -0,0 +1,323 @@
+/*
+Package listview implements a scrollable list that only builds the rows near the visible region.
+
+ func (v *ContactsView) Build(ctx *view.Context) view.Model {
+ 	list := listview.New()
+ 	list.RowCount = len(v.contacts)
+ 	list.EstimateRowHeight = func(idx int) float64 {
+ 		return 60
+ 	}
+ 	list.BuildRow = func(idx int) view.View {
+ 		row := NewContactRow()
+ 		row.Contact = v.contacts[idx]
+ 		return row
+ 	}
+ 	return view.Model{
+ 		Children: []view.View{list},
+ 	}
+ }
+
+Rows are placed from top to bottom and sized by their own layouters, using the width of the list. Rows that have
+not been laid out yet are assumed to have their estimated height. As the list scrolls, rows that leave the visible
+region are removed and their nodes are reused for the rows that enter it. When a row's measured height differs from
+its estimate, the scroll position is adjusted so the visible rows do not move.
+
+Row heights are cached, so rows can be appended to or removed from the end of the list by changing RowCount. If rows
+are inserted, removed or replaced elsewhere, or their estimated heights change, call InvalidateRowHeights() so that
+they are estimated and measured again.
+*/
+package listview
+
+import (
+	"math"
+	"sort"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+	"gomatcha.io/matcha/view"
+	"gomatcha.io/matcha/view/scrollview"
+)
+
+const (
+	// DefaultRowHeight is the estimated height of rows if EstimateRowHeight is nil.
+	DefaultRowHeight = 44
+	// DefaultOverscan is the default distance beyond the visible region in which rows are built.
+	DefaultOverscan = 250
+	// initialViewportHeight is the assumed height of the visible region before the list's first layout.
+	initialViewportHeight = 1000
+)
+
+// View is a vertical scroll view that builds rows on demand.
+type View struct {
+	view.Embed
+	// RowCount is the number of rows in the list.
+	RowCount int
+	// BuildRow returns the view for row idx. It is only called for rows near the visible region.
+	BuildRow func(idx int) view.View
+	// EstimateRowHeight returns the expected height of row idx before it has been laid out.
+	EstimateRowHeight func(idx int) float64
+	// Overscan is the distance above and below the visible region in which rows are built.
+	Overscan float64
+
+	ScrollIndicatorDirection scrollview.Direction
+	ScrollEnabled            bool
+	ScrollPosition           *scrollview.ScrollPosition
+	OnScroll                 func(position layout.Point)
+	PaintStyle               *paint.Style
+
+	scrollPosition *scrollview.ScrollPosition
+	notifyPosition *scrollview.ScrollPosition
+	notifyId       comm.Id
+	heights        []float64 // measured or estimated height of each row
+	starts         []float64 // position of the top of each row, followed by the height of the content
+	validStarts    int       // number of leading entries in starts that are up to date
+	first, last    int
+	poolSize       int
+}
+
+// New returns either the previous View in ctx with matching key, or a new View if none exists.
+func New() *View {
+	return &View{
+		Overscan:                 DefaultOverscan,
+		ScrollIndicatorDirection: scrollview.Vertical,
+		ScrollEnabled:            true,
+	}
+}
+
+// Lifecycle implements view.View.
+func (v *View) Lifecycle(from, to view.Stage) {
+	if view.ExitsStage(from, to, view.StageMounted) {
+		v.setNotifyPosition(nil)
+	}
+}
+
+// Build implements view.View.
+func (v *View) Build(ctx *view.Context) view.Model {
+	position := v.position()
+	v.setNotifyPosition(position)
+
+	// Build the rows in and near the visible region. Rows are keyed by their slot in a pool of nodes, so
+	// a row that scrolls out of view hands its node to the row that scrolls in.
+	v.first, v.last = v.visibleRange()
+	if count := v.last - v.first; count > v.poolSize {
+		v.poolSize = count
+	}
+	rows := []view.View{}
+	indexes := []int{}
+	for i := v.first; i < v.last; i++ {
+		rows = append(rows, &rowView{
+			Embed: view.Embed{Key: i % v.poolSize},
+			Child: v.BuildRow(i),
+		})
+		indexes = append(indexes, i)
+	}
+
+	child := scrollview.New()
+	child.Direction = scrollview.Vertical
+	child.ScrollIndicatorDirection = v.ScrollIndicatorDirection
+	child.ScrollEnabled = v.ScrollEnabled
+	child.ScrollPosition = position
+	child.OnScroll = v.OnScroll
+	child.PaintStyle = v.PaintStyle
+	child.ContentLayouter = &layouter{list: v, indexes: indexes}
+	child.ContentChildren = rows
+
+	return view.Model{
+		Children: []view.View{child},
+	}
+}
+
+func (v *View) position() *scrollview.ScrollPosition {
+	if v.ScrollPosition != nil {
+		return v.ScrollPosition
+	}
+	if v.scrollPosition == nil {
+		v.scrollPosition = &scrollview.ScrollPosition{}
+	}
+	return v.scrollPosition
+}
+
+// setNotifyPosition rebuilds the list whenever p scrolls to a region with unbuilt rows.
+func (v *View) setNotifyPosition(p *scrollview.ScrollPosition) {
+	if p == v.notifyPosition {
+		return
+	}
+	if v.notifyPosition != nil {
+		v.notifyPosition.Unnotify(v.notifyId)
+	}
+	v.notifyPosition = p
+	if p != nil {
+		v.notifyId = p.Notify(v.signalIfNeeded)
+	}
+}
+
+func (v *View) signalIfNeeded() {
+	if first, last := v.visibleRange(); first != v.first || last != v.last {
+		v.Signal()
+	}
+}
+
+// InvalidateRowHeights forgets the heights of all rows, so that they use their estimated heights until they are laid
+// out again. It should be called when rows are inserted or removed other than at the end, or when the contents of the
+// rows change.
+func (v *View) InvalidateRowHeights() {
+	v.heights = nil
+	v.starts = nil
+	v.validStarts = 0
+}
+
+func (v *View) estimateRowHeight(idx int) float64 {
+	if v.EstimateRowHeight != nil {
+		return v.EstimateRowHeight(idx)
+	}
+	return DefaultRowHeight
+}
+
+// setRowHeight records the measured height of row idx.
+func (v *View) setRowHeight(idx int, h float64) {
+	if idx < len(v.heights) && v.heights[idx] != h {
+		v.heights[idx] = h
+		if idx+1 < v.validStarts {
+			v.validStarts = idx + 1
+		}
+	}
+}
+
+// resize estimates the heights of rows that were appended and forgets the heights of rows that were removed.
+func (v *View) resize() {
+	count := v.RowCount
+	if count < 0 {
+		count = 0
+	}
+	if v.starts == nil {
+		v.starts = []float64{0}
+		v.validStarts = 1
+	}
+	if count < len(v.heights) {
+		v.heights = v.heights[:count]
+		v.starts = v.starts[:count+1]
+		if v.validStarts > count+1 {
+			v.validStarts = count + 1
+		}
+	}
+	for i := len(v.heights); i < count; i++ {
+		v.heights = append(v.heights, v.estimateRowHeight(i))
+		v.starts = append(v.starts, 0)
+	}
+}
+
+// rowStarts returns the position of the top of each row, followed by the height of the content. Only the positions
+// after a row whose height changed are recalculated.
+func (v *View) rowStarts() []float64 {
+	v.resize()
+	for i := v.validStarts; i < len(v.starts); i++ {
+		v.starts[i] = v.starts[i-1] + v.heights[i-1]
+	}
+	v.validStarts = len(v.starts)
+	return v.starts
+}
+
+// visibleRange returns the rows that intersect the visible region extended by the overscan.
+func (v *View) visibleRange() (first, last int) {
+	if v.RowCount <= 0 || v.BuildRow == nil {
+		return 0, 0
+	}
+	position := v.position()
+	viewport := position.ViewportSize().Y
+	if viewport <= 0 {
+		viewport = initialViewportHeight
+	}
+	overscan := math.Max(v.Overscan, 0)
+	top := position.Value().Y - overscan
+	bottom := position.Value().Y + viewport + overscan
+
+	starts := v.rowStarts()
+	first = sort.Search(v.RowCount, func(i int) bool {
+		return starts[i+1] > top
+	})
+	last = sort.Search(v.RowCount, func(i int) bool {
+		return starts[i] >= bottom
+	})
+	if last < first {
+		last = first
+	}
+	if first == last && first == v.RowCount {
+		// Scrolled past the end. Build the final row so the list is not empty.
+		first = v.RowCount - 1
+	}
+	if last == first {
+		last = first + 1
+	}
+	return first, last
+}
+
+// rowView wraps a row so that it can be keyed by its slot in the pool.
+type rowView struct {
+	view.Embed
+	Child view.View
+}
+
+func (v *rowView) Build(ctx *view.Context) view.Model {
+	return view.Model{
+		Children: []view.View{v.Child},
+	}
+}
+
+type layouter struct {
+	list    *View
+	indexes []int
+}
+
+// Layout implements the view.Layouter interface.
+func (l *layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	v := l.list
+	width := ctx.MinSize.X
+	position := v.position()
+	offset := position.Value()
+
+	// Find the first visible row, and where it was before measuring.
+	anchor := -1
+	var anchorStart float64
+	starts := v.rowStarts()
+	for _, i := range l.indexes {
+		if starts[i+1] > offset.Y {
+			anchor, anchorStart = i, starts[i]
+			break
+		}
+	}
+
+	gs := make([]layout.Guide, len(l.indexes))
+	for idx, i := range l.indexes {
+		g := ctx.LayoutChild(idx, layout.Pt(width, 0), layout.Pt(width, math.Inf(1)))
+		v.setRowHeight(i, g.Height())
+		gs[idx] = g
+	}
+
+	starts = v.rowStarts()
+	for idx, i := range l.indexes {
+		gs[idx].Frame = layout.Rt(0, starts[i], width, starts[i]+v.heights[i])
+		gs[idx].ZIndex = idx
+	}
+
+	// Keep the first visible row in place if the rows above it changed size. Notifications during layout are
+	// handled in the next update.
+	if anchor >= 0 && offset.Y > 0 {
+		if delta := starts[anchor] - anchorStart; delta != 0 {
+			position.SetValue(layout.Pt(offset.X, math.Max(offset.Y+delta, 0)))
+		}
+	}
+	v.signalIfNeeded()
+
+	return layout.Guide{Frame: layout.Rt(0, 0, width, starts[v.RowCount])}, gs
+}
+
+// Notify implements the view.Layouter interface.
+func (l *layouter) Notify(f func()) comm.Id {
+	return 0 // no-op
+}
+
+// Unnotify implements the view.Layouter interface.
+func (l *layouter) Unnotify(id comm.Id) {
+	// no-op
+}
@@ -0,0 +1,146 @@
+package listview
+
+import (
+	"testing"
+
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/view"
+)
+
+func newList(count int) *View {
+	v := New()
+	v.RowCount = count
+	v.Overscan = 0
+	v.EstimateRowHeight = func(idx int) float64 {
+		return 10
+	}
+	v.BuildRow = func(idx int) view.View {
+		return nil
+	}
+	return v
+}
+
+func layoutRows(v *View, first, last int, height float64) (layout.Guide, []layout.Guide) {
+	l := &layouter{list: v}
+	for i := first; i < last; i++ {
+		l.indexes = append(l.indexes, i)
+	}
+	ctx := &layout.Context{
+		MinSize:    layout.Pt(100, 0),
+		ChildCount: len(l.indexes),
+		LayoutFunc: func(idx int, min, max layout.Point) layout.Guide {
+			return layout.Guide{Frame: layout.Rt(0, 0, min.X, height)}
+		},
+	}
+	return l.Layout(ctx)
+}
+
+func TestVisibleRange(t *testing.T) {
+	v := newList(10000)
+	if first, last := v.visibleRange(); first != 0 || last != initialViewportHeight/10 {
+		t.Errorf("Incorrect initial range: %v-%v", first, last)
+	}
+
+	v.position().SetValue(layout.Pt(0, 50005))
+	if first, last := v.visibleRange(); first != 5000 || last != 5101 {
+		t.Errorf("Incorrect scrolled range: %v-%v", first, last)
+	}
+
+	v.Overscan = 20
+	if first, last := v.visibleRange(); first != 4998 || last != 5103 {
+		t.Errorf("Incorrect overscan range: %v-%v", first, last)
+	}
+
+	v.position().SetValue(layout.Pt(0, 200000))
+	if first, last := v.visibleRange(); first != 9999 || last != 10000 {
+		t.Errorf("Incorrect range past the end: %v-%v", first, last)
+	}
+}
+
+func TestLayout(t *testing.T) {
+	v := newList(100)
+	g, gs := layoutRows(v, 0, 3, 20)
+	if g.Frame != layout.Rt(0, 0, 100, 20*3+10*97) {
+		t.Errorf("Incorrect content frame: %v", g.Frame)
+	}
+	if gs[0].Frame != layout.Rt(0, 0, 100, 20) || gs[2].Frame != layout.Rt(0, 40, 100, 60) {
+		t.Errorf("Incorrect row frames: %v", gs)
+	}
+}
+
+func TestStableOffset(t *testing.T) {
+	v := newList(100)
+	v.position().SetValue(layout.Pt(0, 505))
+
+	// Rows 40-49 were estimated at 10 points, but measure at 20.
+	_, gs := layoutRows(v, 40, 60, 20)
+	if offset := v.position().Value().Y; offset != 605 {
+		t.Errorf("Incorrect offset after correcting estimates: %v", offset)
+	}
+	if gs[10].Frame.Min.Y != 600 {
+		t.Errorf("Incorrect anchor frame: %v", gs[10].Frame)
+	}
+
+	// Remeasuring at the same height does not move the offset.
+	layoutRows(v, 50, 60, 20)
+	if offset := v.position().Value().Y; offset != 605 {
+		t.Errorf("Offset moved without a height change: %v", offset)
+	}
+}
+
+func TestAppendRow(t *testing.T) {
+	v := newList(3)
+	layoutRows(v, 0, 3, 20)
+
+	// Appending a row keeps the measured heights.
+	v.RowCount = 4
+	if starts := v.rowStarts(); starts[3] != 60 || starts[4] != 70 {
+		t.Errorf("Incorrect starts after appending a row: %v", starts)
+	}
+
+	// Removing rows from the end forgets their heights.
+	v.RowCount = 1
+	v.rowStarts()
+	v.RowCount = 2
+	if starts := v.rowStarts(); starts[1] != 20 || starts[2] != 30 {
+		t.Errorf("Incorrect starts after removing rows: %v", starts)
+	}
+}
+
+func TestInsertRow(t *testing.T) {
+	v := newList(3)
+	g, _ := layoutRows(v, 0, 3, 20)
+	if g.Frame.Max.Y != 60 {
+		t.Errorf("Incorrect content height: %v", g.Frame.Max.Y)
+	}
+
+	// Insert a row at the top. The measured heights belonged to the old indexes.
+	v.RowCount = 4
+	v.InvalidateRowHeights()
+	if starts := v.rowStarts(); starts[1] != 10 || starts[4] != 40 {
+		t.Errorf("Incorrect starts after inserting a row: %v", starts)
+	}
+
+	g, gs := layoutRows(v, 0, 4, 20)
+	if g.Frame.Max.Y != 80 || gs[3].Frame != layout.Rt(0, 60, 100, 80) {
+		t.Errorf("Incorrect frames after inserting a row: %v %v", g.Frame, gs)
+	}
+}
+
+func TestEstimateCache(t *testing.T) {
+	v := newList(10000)
+	calls := 0
+	v.EstimateRowHeight = func(idx int) float64 {
+		calls += 1
+		return 10
+	}
+	v.visibleRange()
+	v.position().SetValue(layout.Pt(0, 50005))
+	if first, last := v.visibleRange(); first != 5000 || last != 5101 {
+		t.Errorf("Incorrect scrolled range: %v-%v", first, last)
+	}
+	layoutRows(v, 5000, 5101, 10)
+	if calls != 10000 {
+		t.Errorf("Rows were estimated %v times", calls)
+	}
+}
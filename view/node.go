@@ -20,6 +20,7 @@ import (
 	"gomatcha.io/matcha/layout"
 	"gomatcha.io/matcha/layout/full"
 	"gomatcha.io/matcha/paint"
+	pblayout "gomatcha.io/matcha/pb/layout"
 	pb "gomatcha.io/matcha/pb/view"
 )
 
@@ -129,6 +130,54 @@ func (r *Root) SetSize(p layout.Point) {
 	r.root.addFlag(r.root.node.id, layoutFlag)
 }
 
+// LayoutDirection returns the layout direction of r.
+func (r *Root) LayoutDirection() layout.Direction {
+	matcha.MainLocker.Lock()
+	defer matcha.MainLocker.Unlock()
+
+	return r.root.direction
+}
+
+// SetLayoutDirection sets the layout direction of r, and rebuilds all of its views. Use
+// layout.DirectionForLanguage to find the direction of the user's language.
+func (r *Root) SetLayoutDirection(d layout.Direction) {
+	matcha.MainLocker.Lock()
+	defer matcha.MainLocker.Unlock()
+
+	r.root.direction = d
+	// Views that were skipped by their parents would not otherwise be rebuilt.
+	for id := range r.root.nodes {
+		r.root.addFlag(id, buildFlag)
+	}
+}
+
+// Snapshot applies any pending updates to r, and returns its entire view hierarchy, including views that have not
+// changed since they were last sent to the native side. It is meant for tests and tools, such as rendering a
+// hierarchy with the snapshot package.
+func (r *Root) Snapshot() *pb.Root {
+	matcha.MainLocker.Lock()
+	defer matcha.MainLocker.Unlock()
+
+	if r.root.update(r.size) {
+		// The update has not been sent to the native side, so send it on the next tick.
+		r.root.addFlag(r.root.node.id, paintFlag)
+	}
+	return r.root.marshalProtobuf(true)
+}
+
+// HitTest returns the path of Ids from the root to the frontmost view that contains p, taking the transforms of
+// each view into account. It returns nil if p is outside of r.
+func (r *Root) HitTest(p layout.Point) []Id {
+	matcha.MainLocker.Lock()
+	defer matcha.MainLocker.Unlock()
+
+	local, ok := r.root.node.toLocal(p)
+	if !ok {
+		return nil
+	}
+	return r.root.node.hitTest(local)
+}
+
 // Context specifies the supporting context for building a View.
 type Context struct {
 	valid     bool
@@ -150,6 +199,15 @@ func (ctx *Context) SkipBuild(ids ...int) {
 	}
 }
 
+// LayoutDirection returns the layout direction of the view. Views should align their content, such as text, to the
+// right when it is layout.RightToLeft.
+func (ctx *Context) LayoutDirection() layout.Direction {
+	if ctx.node == nil {
+		return layout.LeftToRight
+	}
+	return ctx.node.root.direction
+}
+
 // Path returns the path of Ids from the root to the view.
 func (ctx *Context) Path() []Id {
 	if ctx.node == nil {
@@ -185,6 +243,23 @@ type root struct {
 
 	flagMu      sync.Mutex
 	updateFlags map[Id]updateFlag
+	// flags are the updateFlags being processed by the current update. Flags
+	// added while an update is in progress are deferred to the next update.
+	flags map[Id]updateFlag
+	// invalidLayout contains the nodes whose cached layouts must be discarded
+	// during the current update.
+	invalidLayout map[Id]bool
+
+	// pendingTransaction is the animation transaction that updateFlags were
+	// added in, and transaction is the one for the current update.
+	pendingTransaction *transaction
+	transaction        *transaction
+	// animating is true while any node has a running implicit animation.
+	animating bool
+	// now is the time of the current update.
+	now time.Time
+	// direction is the layout direction of every view.
+	direction layout.Direction
 }
 
 func newRoot(v View) *root {
@@ -208,14 +283,22 @@ func (root *root) addFlag(id Id, f updateFlag) {
 	defer root.flagMu.Unlock()
 
 	root.updateFlags[id] |= f
+	if t := currentTransaction(); t != nil {
+		root.pendingTransaction = t
+	}
 }
 
 func (root *root) update(size layout.Point) bool {
 	root.flagMu.Lock()
-	defer root.flagMu.Unlock()
+	root.flags = root.updateFlags
+	root.updateFlags = map[Id]updateFlag{}
+	root.transaction = root.pendingTransaction
+	root.pendingTransaction = nil
+	root.flagMu.Unlock()
+	root.now = internal.Now()
 
 	var flag updateFlag
-	for _, v := range root.updateFlags {
+	for _, v := range root.flags {
 		flag |= v
 	}
 
@@ -225,14 +308,22 @@ func (root *root) update(size layout.Point) bool {
 		updated = true
 	}
 	if flag.needsLayout() {
+		root.invalidateLayout()
 		root.layout(size, size)
 		updated = true
 	}
-	if flag.needsPaint() {
+	if flag.needsPaint() || flag.needsLayout() {
+		// Layout changes may resize views that draw custom content.
 		root.paint()
 		updated = true
 	}
-	root.updateFlags = map[Id]updateFlag{}
+	if updated || root.animating {
+		root.animating = root.animate(root.now)
+		updated = true
+	}
+	root.flags = nil
+	root.invalidLayout = nil
+	root.transaction = nil
 	return updated
 }
 
@@ -241,11 +332,17 @@ func (root *root) MarshalProtobuf2() ([]byte, error) {
 }
 
 func (root *root) MarshalProtobuf() *pb.Root {
+	return root.marshalProtobuf(false)
+}
+
+// marshalProtobuf serializes root. If all is false, only the build nodes that have changed since the last call are
+// included.
+func (root *root) marshalProtobuf(all bool) *pb.Root {
 	m := map[int64]*pb.LayoutPaintNode{}
 	root.node.marshalLayoutPaintProtobuf(m)
 
 	m2 := map[int64]*pb.BuildNode{}
-	root.node.marshalBuildProtobuf(m2)
+	root.node.marshalBuildProtobuf(m2, all)
 
 	m3 := map[string]*any.Any{}
 	for _, i := range root.middlewares {
@@ -272,10 +369,29 @@ func (root *root) build() {
 	root.node.build()
 }
 
+// invalidateLayout marks the nodes that need layout, and all of their ancestors, as
+// having an invalid layout cache.
+func (root *root) invalidateLayout() {
+	root.invalidLayout = map[Id]bool{}
+	for id, f := range root.flags {
+		if !f.needsLayout() {
+			continue
+		}
+		n, ok := root.nodes[id]
+		if !ok {
+			continue
+		}
+		for _, i := range n.path {
+			root.invalidLayout[i] = true
+		}
+	}
+}
+
 func (root *root) layout(minSize layout.Point, maxSize layout.Point) {
 	g := root.node.layout(minSize, maxSize)
 	g.Frame = g.Frame.Add(layout.Pt(-g.Frame.Min.X, -g.Frame.Min.Y)) // Move Frame.Min to the origin.
 	root.node.layoutGuide = &g
+	root.node.finishLayout()
 }
 
 func (root *root) paint() {
@@ -317,21 +433,29 @@ type node struct {
 	layoutNotify   bool
 	layoutNotifyId comm.Id
 	layoutGuide    *layout.Guide
-	layoutMinSize  layout.Point
-	layoutMaxSize  layout.Point
+	layoutKey      layoutKey
+	layoutCache    []*layoutCacheEntry
 
 	paintId       int64
 	paintNotify   bool
 	paintNotifyId comm.Id
 	paintOptions  paint.Style
+	drawSize      layout.Point
+
+	// animation interpolates from the previously displayed frame and paint
+	// style to the current ones.
+	animation      *implicitAnimation
+	displayed      bool
+	displayedGuide layout.Guide
+	displayedStyle paint.Style
 }
 
 func (n *node) marshalLayoutPaintProtobuf(m map[int64]*pb.LayoutPaintNode) {
-	guide := n.layoutGuide
 	if n.layoutGuide == nil {
-		guide = &layout.Guide{}
 		fmt.Println("View is missing layout guide", n.id, n.view)
 	}
+	guide := n.displayGuide(n.root.now)
+	style := n.displayStyle(n.root.now)
 
 	// Sort children by zIndex for performance reasons.
 	childOrder := []struct {
@@ -356,6 +480,11 @@ func (n *node) marshalLayoutPaintProtobuf(m map[int64]*pb.LayoutPaintNode) {
 		order = append(order, i.id)
 	}
 
+	var transform *pblayout.Transform
+	if guide.Transform != (layout.Transform{}) {
+		transform = guide.Transform.MarshalProtobuf()
+	}
+
 	m[int64(n.id)] = &pb.LayoutPaintNode{
 		Id:       int64(n.id),
 		LayoutId: n.layoutId,
@@ -368,24 +497,27 @@ func (n *node) marshalLayoutPaintProtobuf(m map[int64]*pb.LayoutPaintNode) {
 		Maxy:       guide.Frame.Max.Y,
 		ZIndex:     int64(guide.ZIndex),
 		ChildOrder: order,
+		Transform:  transform,
 
-		PaintStyle: n.paintOptions.MarshalProtobuf(),
+		PaintStyle: style.MarshalProtobuf(),
 	}
 	for _, v := range n.children {
 		v.marshalLayoutPaintProtobuf(m)
 	}
 }
 
-func (n *node) marshalBuildProtobuf(m map[int64]*pb.BuildNode) {
+func (n *node) marshalBuildProtobuf(m map[int64]*pb.BuildNode, all bool) {
 	for _, v := range n.children {
-		v.marshalBuildProtobuf(m)
+		v.marshalBuildProtobuf(m, all)
 	}
 
 	// Don't build if nothing has changed
-	if n.buildPbId == n.buildId {
-		return
+	if !all {
+		if n.buildPbId == n.buildId {
+			return
+		}
+		n.buildPbId = n.buildId
 	}
-	n.buildPbId = n.buildId
 
 	children := []int64{}
 	for _, v := range n.children {
@@ -418,7 +550,7 @@ func (n *node) marshalBuildProtobuf(m map[int64]*pb.BuildNode) {
 }
 
 func (n *node) build() {
-	if n.root.updateFlags[n.id].needsBuild() {
+	if n.root.flags[n.id].needsBuild() {
 		n.buildId += 1
 
 		// Send lifecycle event to new children.
@@ -492,7 +624,7 @@ func (n *node) build() {
 
 				// Mark as needing rebuild
 				if _, ok := ctx.skipBuild[idx]; !ok {
-					n.root.updateFlags[prevNode.id] |= buildFlag
+					n.root.flags[prevNode.id] |= buildFlag
 				}
 			} else {
 				// If view was added for the first time...
@@ -511,7 +643,7 @@ func (n *node) build() {
 				})
 
 				// Mark as needing rebuild
-				n.root.updateFlags[id] |= buildFlag
+				n.root.flags[id] |= buildFlag
 			}
 		}
 
@@ -566,27 +698,69 @@ func (n *node) build() {
 	}
 }
 
+// layoutCacheSize is the number of measurements that each node caches.
+const layoutCacheSize = 4
+
+type layoutKey struct {
+	minSize layout.Point
+	maxSize layout.Point
+}
+
+// layoutCacheEntry is the result of laying out a node with a min and max size.
+type layoutCacheEntry struct {
+	key    layoutKey
+	guide  layout.Guide
+	guides []layout.Guide
+	// childKeys are the sizes that each child was last laid out with.
+	childKeys map[int]layoutKey
+}
+
 func (n *node) layout(minSize layout.Point, maxSize layout.Point) layout.Guide {
 	n.layoutId += 1
+	key := layoutKey{minSize: minSize, maxSize: maxSize}
 
-	// If node has no children, has the same min/max size, and does not need relayout, return the previous guide.
-	if len(n.children) == 0 && n.layoutGuide != nil && n.layoutMinSize == minSize && n.layoutMaxSize == maxSize && !n.root.updateFlags[n.id].needsLayout() {
-		return *n.layoutGuide
+	// Discard the cache if the node or any of its descendants need relayout. This
+	// only happens on the first layout of each update, so the node can still be
+	// measured repeatedly.
+	if n.root.invalidLayout[n.id] {
+		n.root.invalidLayout[n.id] = false
+		n.layoutCache = nil
+	}
+
+	// If the node has been laid out with the same min/max size, return the cached guide.
+	for idx, entry := range n.layoutCache {
+		if entry.key != key {
+			continue
+		}
+		copy(n.layoutCache[1:idx+1], n.layoutCache[:idx])
+		n.layoutCache[0] = entry
+
+		// Children may have been laid out with other sizes since, so restore them.
+		for idx, i := range n.children {
+			if k, ok := entry.childKeys[idx]; ok && k != i.layoutKey {
+				i.layout(k.minSize, k.maxSize)
+			}
+			g := entry.guides[idx]
+			i.layoutGuide = &g
+		}
+		n.layoutKey = key
+		return entry.guide
 	}
-	n.layoutMinSize = minSize
-	n.layoutMaxSize = maxSize
 
 	// Create the LayoutContext
+	childKeys := map[int]layoutKey{}
 	ctx := &layout.Context{
 		MinSize:    minSize,
 		MaxSize:    maxSize,
 		ChildCount: len(n.children),
+		Direction:  n.root.direction,
 		LayoutFunc: func(idx int, minSize, maxSize layout.Point) layout.Guide {
 			if idx >= len(n.children) {
 				fmt.Println("Attempting to layout unknown child: ", idx)
 				return layout.Guide{}
 			}
 			child := n.children[idx]
+			childKeys[idx] = layoutKey{minSize: minSize, maxSize: maxSize}
 			return child.layout(minSize, maxSize)
 		},
 	}
@@ -600,15 +774,88 @@ func (n *node) layout(minSize layout.Point, maxSize layout.Point) layout.Guide {
 	g = g.Fit(ctx)
 
 	//
+	guides := make([]layout.Guide, len(n.children))
 	for idx, i := range n.children {
 		g2 := gs[idx]
 		i.layoutGuide = &g2
+		guides[idx] = g2
+	}
+	n.layoutKey = key
+
+	// Cache the result.
+	entry := &layoutCacheEntry{key: key, guide: g, guides: guides, childKeys: childKeys}
+	n.layoutCache = append([]*layoutCacheEntry{entry}, n.layoutCache...)
+	if len(n.layoutCache) > layoutCacheSize {
+		n.layoutCache = n.layoutCache[:layoutCacheSize]
 	}
 	return g
 }
 
+// finishLayout tells the layouters of n and its descendants which of their layouts was final.
+func (n *node) finishLayout() {
+	if f, ok := n.model.Layouter.(layout.Finisher); ok {
+		f.FinishLayout(n.layoutKey.minSize, n.layoutKey.maxSize)
+	}
+	for _, i := range n.children {
+		i.finishLayout()
+	}
+}
+
+// toLocal converts p from the coordinate space of n's parent into the coordinate space of n. The transform of n's
+// layout guide is applied before the transform of its paint style.
+func (n *node) toLocal(p layout.Point) (layout.Point, bool) {
+	if n.layoutGuide == nil {
+		return layout.Point{}, false
+	}
+	frame := n.layoutGuide.Frame
+	t := n.layoutGuide.Transform.InFrame(frame).Concat(n.paintOptions.Transform.InFrame(frame))
+	t, ok := t.Invert()
+	if !ok {
+		return layout.Point{}, false
+	}
+	p = t.Apply(p)
+	return layout.Pt(p.X-frame.Min.X, p.Y-frame.Min.Y), true
+}
+
+// hitTest returns the path of Ids from n to its frontmost descendant that contains p, which is in the coordinate
+// space of n.
+func (n *node) hitTest(p layout.Point) []Id {
+	g := n.layoutGuide
+	if g == nil || p.X < 0 || p.Y < 0 || p.X > g.Width() || p.Y > g.Height() {
+		return nil
+	}
+
+	// Children with a higher zIndex, or that come later, are in front.
+	children := append([]*node(nil), n.children...)
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].zIndex() < children[j].zIndex()
+	})
+	for idx := len(children) - 1; idx >= 0; idx-- {
+		local, ok := children[idx].toLocal(p)
+		if !ok {
+			continue
+		}
+		if path := children[idx].hitTest(local); path != nil {
+			return append([]Id{n.id}, path...)
+		}
+	}
+	return []Id{n.id}
+}
+
+func (n *node) zIndex() int {
+	if n.layoutGuide == nil {
+		return 0
+	}
+	return n.layoutGuide.ZIndex
+}
+
 func (n *node) paint() {
-	if n.root.updateFlags[n.id].needsPaint() {
+	size := layout.Point{}
+	if n.layoutGuide != nil {
+		size = n.layoutGuide.Frame.Size().Point()
+	}
+	d, drawer := n.model.Painter.(paint.Drawer)
+	if n.root.flags[n.id].needsPaint() || (drawer && size != n.drawSize) {
 		n.paintId += 1
 
 		if p := n.model.Painter; p != nil {
@@ -616,6 +863,12 @@ func (n *node) paint() {
 		} else {
 			n.paintOptions = paint.Style{}
 		}
+		if drawer {
+			c := paint.NewCanvas(size)
+			d.DrawCanvas(c)
+			n.paintOptions.Drawing = c.Drawing()
+			n.drawSize = size
+		}
 	}
 
 	// Recursively update children
@@ -0,0 +1,148 @@
+package view
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gomatcha.io/matcha/comm"
+	"gomatcha.io/matcha/layout"
+	"gomatcha.io/matcha/paint"
+)
+
+type countLayouter struct {
+	comm.Relay
+	count int
+}
+
+func (l *countLayouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	l.count += 1
+	gs := []layout.Guide{}
+	y := 0.0
+	for i := 0; i < ctx.ChildCount; i++ {
+		g := ctx.LayoutChild(i, layout.Pt(ctx.MinSize.X, 0), layout.Pt(ctx.MinSize.X, 10))
+		g.Frame = g.Frame.Add(layout.Pt(0, y))
+		y = g.Frame.Max.Y
+		gs = append(gs, g)
+	}
+	return layout.Guide{Frame: layout.Rt(0, 0, ctx.MinSize.X, y)}, gs
+}
+
+type countView struct {
+	Embed
+	layouter *countLayouter
+	children []View
+}
+
+func (v *countView) Build(ctx *Context) Model {
+	return Model{Children: v.children, Layouter: v.layouter}
+}
+
+func TestLayoutCache(t *testing.T) {
+	a := &countView{layouter: &countLayouter{}}
+	b := &countView{layouter: &countLayouter{}}
+	parent := &countView{layouter: &countLayouter{}, children: []View{a, b}}
+
+	r := newRoot(parent)
+	size := layout.Pt(100, 100)
+	r.update(size)
+	if parent.layouter.count != 1 || a.layouter.count != 1 || b.layouter.count != 1 {
+		t.Fatalf("Incorrect layout counts: %v %v %v", parent.layouter.count, a.layouter.count, b.layouter.count)
+	}
+
+	// Only a and its ancestors are laid out again.
+	a.layouter.Signal()
+	r.update(size)
+	if parent.layouter.count != 2 || a.layouter.count != 2 || b.layouter.count != 1 {
+		t.Errorf("Incorrect layout counts: %v %v %v", parent.layouter.count, a.layouter.count, b.layouter.count)
+	}
+	if g := r.node.children[1].layoutGuide; g == nil || g.Frame != layout.Rt(0, 0, 100, 0) {
+		t.Errorf("Incorrect guide: %v", g)
+	}
+
+	// Changing the size relays out everything.
+	r.addFlag(r.node.id, layoutFlag)
+	r.update(layout.Pt(50, 100))
+	if parent.layouter.count != 3 || a.layouter.count != 3 || b.layouter.count != 2 {
+		t.Errorf("Incorrect layout counts: %v %v %v", parent.layouter.count, a.layouter.count, b.layouter.count)
+	}
+}
+
+type guideLayouter struct {
+	comm.Relay
+	guides []layout.Guide
+}
+
+func (l *guideLayouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
+	for i := 0; i < ctx.ChildCount; i++ {
+		ctx.LayoutChild(i, layout.Pt(0, 0), layout.Pt(0, 0))
+	}
+	return layout.Guide{Frame: layout.Rt(0, 0, ctx.MinSize.X, ctx.MinSize.Y)}, l.guides
+}
+
+type guideView struct {
+	Embed
+	layouter *guideLayouter
+	children []View
+}
+
+func (v *guideView) Build(ctx *Context) Model {
+	return Model{Children: v.children, Layouter: v.layouter}
+}
+
+func TestHitTest(t *testing.T) {
+	a := &countView{layouter: &countLayouter{}}
+	b := &countView{layouter: &countLayouter{}}
+	parent := &guideView{
+		layouter: &guideLayouter{guides: []layout.Guide{
+			{Frame: layout.Rt(0, 0, 50, 50), ZIndex: 1},
+			// b is rotated a quarter turn, so it covers x from 25 to 75, and y from 0 to 100.
+			{Frame: layout.Rt(0, 25, 100, 75), Transform: layout.Transform{}.Rotate(math.Pi/2, 0, 0, 1)},
+		}},
+		children: []View{a, b},
+	}
+	r := &Root{root: newRoot(parent)}
+	r.root.update(layout.Pt(100, 100))
+
+	ids := []Id{r.root.node.id, r.root.node.children[0].id, r.root.node.children[1].id}
+	for _, i := range []struct {
+		p    layout.Point
+		path []Id
+	}{
+		{layout.Pt(10, 10), []Id{ids[0], ids[1]}},
+		{layout.Pt(40, 90), []Id{ids[0], ids[2]}},
+		{layout.Pt(90, 50), []Id{ids[0]}},
+		{layout.Pt(150, 50), nil},
+	} {
+		if path := r.HitTest(i.p); !reflect.DeepEqual(path, i.path) {
+			t.Errorf("HitTest(%v) = %v, expected %v", i.p, path, i.path)
+		}
+	}
+}
+
+func TestDrawer(t *testing.T) {
+	sizes := []layout.Point{}
+	painter := &paint.CanvasPainter{Draw: func(c *paint.Canvas) {
+		sizes = append(sizes, c.Size())
+	}}
+	l := &guideLayouter{guides: []layout.Guide{{Frame: layout.Rt(0, 0, 10, 20)}}}
+	r := newRoot(&guideView{layouter: l, children: []View{WithPainter(&countView{layouter: &countLayouter{}}, painter)}})
+	r.update(layout.Pt(100, 100))
+	if !reflect.DeepEqual(sizes, []layout.Point{layout.Pt(10, 20)}) {
+		t.Errorf("Incorrect draws: %v", sizes)
+	}
+	if r.node.children[0].paintOptions.Drawing == nil {
+		t.Error("Expected a drawing")
+	}
+
+	// Moving the view does not redraw it, but resizing it does.
+	l.guides = []layout.Guide{{Frame: layout.Rt(5, 5, 15, 25)}}
+	l.Signal()
+	r.update(layout.Pt(100, 100))
+	l.guides = []layout.Guide{{Frame: layout.Rt(0, 0, 30, 20)}}
+	l.Signal()
+	r.update(layout.Pt(100, 100))
+	if !reflect.DeepEqual(sizes, []layout.Point{layout.Pt(10, 20), layout.Pt(30, 20)}) {
+		t.Errorf("Incorrect draws: %v", sizes)
+	}
+}
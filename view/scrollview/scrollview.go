@@ -114,6 +114,10 @@ func (l *layouter) Layout(ctx *layout.Context) (layout.Guide, []layout.Guide) {
 		minSize.Y = 0
 	}
 
+	if l.scrollPosition != nil {
+		l.scrollPosition.viewportSize = ctx.MinSize
+	}
+
 	g := ctx.LayoutChild(0, minSize, layout.Pt(math.Inf(1), math.Inf(1)))
 	g.Frame = layout.Rt(-l.offset.X, -l.offset.Y, g.Width()-l.offset.X, g.Height()-l.offset.Y)
 	gs := []layout.Guide{g}
@@ -143,10 +147,11 @@ func (l *layouter) Unnotify(id comm.Id) {
 }
 
 type ScrollPosition struct {
-	X           animate.Value
-	Y           animate.Value
-	group       comm.Relay
-	initialized bool
+	X            animate.Value
+	Y            animate.Value
+	group        comm.Relay
+	initialized  bool
+	viewportSize layout.Point
 }
 
 func (p *ScrollPosition) initialize() {
@@ -180,6 +185,11 @@ func (p *ScrollPosition) SetValue(val layout.Point) {
 	p.Y.SetValue(val.Y)
 }
 
+// ViewportSize returns the size of the visible region of the scroll view, as of its most recent layout.
+func (p *ScrollPosition) ViewportSize() layout.Point {
+	return p.viewportSize
+}
+
 // TODO(KD):
 // func (p *ScrollPosition) ScrollToPoint(val layout.Point) {
 // }
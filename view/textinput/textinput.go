@@ -65,14 +65,14 @@ func (v *View) Build(ctx *view.Context) view.Model {
 		t = v.text
 	}
 	st := internal.NewStyledText(t)
-	st.Set(style, 0, 0)
+	st.Set(style.ForDirection(ctx.LayoutDirection()), 0, 0)
 
 	placeholder := v.PlaceholderText
 	if placeholder == nil {
 		placeholder = text.New("")
 	}
 	placeholderStyledText := internal.NewStyledText(placeholder)
-	placeholderStyledText.Set(v.PlaceholderStyle, 0, 0)
+	placeholderStyledText.Set(v.PlaceholderStyle.ForDirection(ctx.LayoutDirection()), 0, 0)
 
 	if v.Responder != v.prevResponder {
 		if v.prevResponder != nil {
@@ -34,7 +34,7 @@ func (v *View) Build(ctx *view.Context) view.Model {
 		t = text.New(v.String)
 	}
 	st := internal.NewStyledText(t)
-	st.Set(v.Style, 0, 0)
+	st.Set(v.Style.ForDirection(ctx.LayoutDirection()), 0, 0)
 
 	painter := paint.Painter(nil)
 	if v.PaintStyle != nil {